@@ -7,12 +7,14 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"project2/internal/app/jobs"
 	"project2/internal/app/repositories"
 	"project2/internal/app/services"
+	"project2/internal/config"
 	"project2/internal/db"
 	"project2/internal/ui"
-	"project2/pkg/utils"
 	"syscall"
+	"time"
 )
 
 func main() {
@@ -36,22 +38,40 @@ func main() {
 	bookingRepo := repositories.NewBookingRepo(client)
 	leaderboardRepo := repositories.NewLeaderboardRepo(client)
 	notificationRepo := repositories.NewNotificationRepo(client)
+	recurringBookingRepo := repositories.NewRecurringBookingRepo(client)
+	blackoutRepo := repositories.NewBlackoutRepo(client)
+	holidayRepo := repositories.NewHolidayRepo(client)
+	resultRepo := repositories.NewResultRepo(client)
+	teamRepo := repositories.NewTeamRepo(client)
 
 	// Initialize services
 	gameService := services.NewGameService(gameRepo)
 	slotService := services.NewSlotService(slotRepo)
 	userService := services.NewUserService(userRepo)
-	bookingService := services.NewBookingService(bookingRepo, slotService, gameService)
-	invitationService := services.NewInvitationService(invitationRepo, bookingService, slotService)
-	leaderboardService := services.NewLeaderboardService(leaderboardRepo, bookingService)
 	notificationService := services.NewNotificationService(notificationRepo)
+	holidayService := services.NewHolidayService(holidayRepo, notificationService)
+	bookingService := services.NewBookingService(bookingRepo, slotService, gameService, notificationService, userService, holidayService)
+	invitationService := services.NewInvitationService(invitationRepo, bookingService, slotService)
+	leaderboardService := services.NewLeaderboardService(leaderboardRepo, gameService)
+	recurringBookingService := services.NewRecurringBookingService(recurringBookingRepo, bookingService, slotService, gameService, notificationService)
+	blackoutService := services.NewBlackoutService(blackoutRepo, notificationService)
+	teamService := services.NewTeamService(teamRepo, userService, gameService)
+	resultService := services.NewResultService(resultRepo, slotService, gameService, leaderboardService, teamService, notificationService)
 
-	// Insert today's slots
-	err = utils.InsertAllSlots(context.Background(), slotRepo, gameRepo)
+	location, err := time.LoadLocation(config.TimeZone)
 	if err != nil {
-		log.Fatal("Error inserting slots:", err)
+		log.Fatal("Error loading time zone:", err)
 	}
 
+	// Start the background jobs. Slots of the booking horizon are generated now, every day at the
+	// configured time and whenever a new game is created, with the recurring bookings booked into them.
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	slotGenerationJob := jobs.NewSlotGenerationJob(slotRepo, gameRepo, blackoutRepo, holidayRepo, recurringBookingService)
+	go jobs.ScheduleDaily(jobsCtx, config.SlotGenerationTime, location, slotGenerationJob.Triggers(), slotGenerationJob)
+	go jobs.Schedule(jobsCtx, config.JobInterval, jobs.NewMinPlayersJob(slotRepo, gameRepo, bookingRepo, notificationRepo))
+	go jobs.Schedule(jobsCtx, config.JobInterval, jobs.NewNoShowJob(bookingRepo, notificationRepo))
+	go jobs.Schedule(jobsCtx, config.JobInterval, jobs.NewResultConfirmationJob(resultService))
+
 	// Graceful shutdown handling
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -59,12 +79,13 @@ func main() {
 	go func() {
 		<-sigChan
 		fmt.Println("Graceful shutdown initiated...")
+		stopJobs()
 		client.Close()
 		fmt.Println("All operations completed. Exiting.")
 		os.Exit(0)
 	}()
 
 	// Initialize and display the UI
-	appUI := ui.NewUI(userService, gameService, slotService, bookingService, invitationService, leaderboardService, notificationService, bufio.NewReader(os.Stdin))
+	appUI := ui.NewUI(userService, gameService, slotService, bookingService, invitationService, leaderboardService, notificationService, recurringBookingService, blackoutService, holidayService, resultService, teamService, slotGenerationJob, bufio.NewReader(os.Stdin))
 	appUI.ShowMainMenu()
 }
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job is a unit of background work that is run periodically while the application is up
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+// Schedule runs the job immediately and then once every interval until the context is cancelled.
+// Failures are logged and the job is retried on the next tick.
+func Schedule(ctx context.Context, interval time.Duration, job Job) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Run(ctx); err != nil {
+			log.Printf("%s failed: %v", job.Name(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScheduleDaily runs the job immediately, then every day at the given HH:MM time of day in the location,
+// and whenever a value is received on trigger, until the context is cancelled.
+// Failures are logged and the job is retried on its next run.
+func ScheduleDaily(ctx context.Context, at string, location *time.Location, trigger <-chan struct{}, job Job) {
+	for {
+		if err := job.Run(ctx); err != nil {
+			log.Printf("%s failed: %v", job.Name(), err)
+		}
+
+		timer := time.NewTimer(time.Until(NextDailyRun(time.Now(), at, location)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		case <-trigger:
+			timer.Stop()
+		}
+	}
+}
+
+// NextDailyRun returns the first occurrence of the HH:MM time of day in the location strictly after now.
+// Invalid times fall back to midnight.
+func NextDailyRun(now time.Time, at string, location *time.Location) time.Time {
+	clock, err := time.Parse("15:04", at)
+	if err != nil {
+		clock = time.Time{}
+	}
+
+	local := now.In(location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, location)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	"project2/pkg/utils"
+	"time"
+)
+
+// MinPlayersJob cancels the bookings of slots that are about to start without enough players
+type MinPlayersJob struct {
+	slotRepo         repository_interfaces.SlotRepository
+	gameRepo         repository_interfaces.GameRepository
+	bookingRepo      repository_interfaces.BookingRepository
+	notificationRepo repository_interfaces.NotificationRepository
+}
+
+func NewMinPlayersJob(slotRepo repository_interfaces.SlotRepository, gameRepo repository_interfaces.GameRepository, bookingRepo repository_interfaces.BookingRepository, notificationRepo repository_interfaces.NotificationRepository) *MinPlayersJob {
+	return &MinPlayersJob{
+		slotRepo:         slotRepo,
+		gameRepo:         gameRepo,
+		bookingRepo:      bookingRepo,
+		notificationRepo: notificationRepo,
+	}
+}
+
+func (j *MinPlayersJob) Name() string {
+	return "min players check"
+}
+
+// Run looks at every slot starting within the configured number of minutes and, for those that have
+// not reached the game's MinPlayers, cancels the bookings, reopens the slot and notifies the players.
+func (j *MinPlayersJob) Run(ctx context.Context) error {
+	now := time.Now()
+	slots, err := j.slotRepo.FetchSlotsStartingBetween(ctx, now, now.Add(time.Duration(config.MinPlayersCheckMinutes)*time.Minute))
+	if err != nil {
+		return fmt.Errorf("failed to fetch upcoming slots: %w", err)
+	}
+
+	games := make(map[uuid.UUID]*entities.Game)
+	for _, slot := range slots {
+		game, ok := games[slot.GameID]
+		if !ok {
+			game, err = j.gameRepo.FetchGameByID(ctx, slot.GameID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch game %s: %w", slot.GameID, err)
+			}
+			games[slot.GameID] = game
+		}
+		if game == nil || game.MinPlayers <= 1 {
+			continue
+		}
+
+		userIDs, err := j.bookingRepo.CancelBookingsBelowMinPlayers(ctx, slot.SlotID, game.MinPlayers)
+		if err != nil {
+			return fmt.Errorf("failed to cancel bookings of slot %s: %w", slot.SlotID, err)
+		}
+
+		for _, userID := range userIDs {
+			notification := &entities.Notification{
+				UserID: userID,
+				Message: fmt.Sprintf("Your %s booking at %s was cancelled because fewer than %d players had joined.",
+					game.GameName, utils.FormatClock(slot.StartTime), game.MinPlayers),
+			}
+			if _, err := j.notificationRepo.CreateNotification(ctx, notification); err != nil {
+				return fmt.Errorf("failed to notify user %s: %w", userID, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	"project2/pkg/utils"
+	"time"
+)
+
+// NoShowJob marks the bookings that were not checked in before the check-in window closed as no-shows
+type NoShowJob struct {
+	bookingRepo      repository_interfaces.BookingRepository
+	notificationRepo repository_interfaces.NotificationRepository
+}
+
+func NewNoShowJob(bookingRepo repository_interfaces.BookingRepository, notificationRepo repository_interfaces.NotificationRepository) *NoShowJob {
+	return &NoShowJob{
+		bookingRepo:      bookingRepo,
+		notificationRepo: notificationRepo,
+	}
+}
+
+func (j *NoShowJob) Name() string {
+	return "no-show check"
+}
+
+// Run marks every booking whose check-in window has recently closed without a check-in as a no-show and notifies
+// the player. Only the slots whose window closed within the last window length and job interval are looked at,
+// so that bookings whose window closed while the application was down are never flagged in bulk.
+func (j *NoShowJob) Run(ctx context.Context) error {
+	window := time.Duration(config.CheckInWindowMinutes) * time.Minute
+	windowClosed := time.Now().Add(-window)
+	noShows, err := j.bookingRepo.MarkNoShows(ctx, windowClosed.Add(-window-config.JobInterval), windowClosed)
+	if err != nil {
+		return fmt.Errorf("failed to mark no-shows: %w", err)
+	}
+
+	for _, noShow := range noShows {
+		notification := &entities.Notification{
+			UserID: noShow.UserId,
+			Message: fmt.Sprintf("You did not check in to your %s booking on %s at %s and have been marked as a no-show.",
+				noShow.GameName, utils.FormatDay(noShow.StartTime), utils.FormatClock(noShow.StartTime)),
+		}
+		if _, err := j.notificationRepo.CreateNotification(ctx, notification); err != nil {
+			return fmt.Errorf("failed to notify user %s: %w", noShow.UserId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	service_interfaces "project2/internal/domain/interfaces/service"
+)
+
+// ResultConfirmationJob confirms the reported match results that were not disputed before their deadline and
+// applies every confirmed result that is not counted in the leaderboard yet
+type ResultConfirmationJob struct {
+	resultService service_interfaces.ResultService
+}
+
+func NewResultConfirmationJob(resultService service_interfaces.ResultService) *ResultConfirmationJob {
+	return &ResultConfirmationJob{
+		resultService: resultService,
+	}
+}
+
+func (j *ResultConfirmationJob) Name() string {
+	return "result confirmation"
+}
+
+// Run confirms every pending result whose confirmation deadline has passed and updates the leaderboard with every
+// confirmed result that has not been applied yet.
+func (j *ResultConfirmationJob) Run(ctx context.Context) error {
+	if _, err := j.resultService.ConfirmExpiredResults(ctx); err != nil {
+		return fmt.Errorf("failed to confirm expired results: %w", err)
+	}
+	return nil
+}
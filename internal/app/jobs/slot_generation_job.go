@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/pkg/utils"
+)
+
+// SlotGenerationJob creates the missing slots of the booking horizon and books the recurring bookings into them
+type SlotGenerationJob struct {
+	slotRepo         repository_interfaces.SlotRepository
+	gameRepo         repository_interfaces.GameRepository
+	blackoutRepo     repository_interfaces.BlackoutRepository
+	holidayRepo      repository_interfaces.HolidayRepository
+	recurringService service_interfaces.RecurringBookingService
+	trigger          chan struct{}
+}
+
+func NewSlotGenerationJob(slotRepo repository_interfaces.SlotRepository, gameRepo repository_interfaces.GameRepository, blackoutRepo repository_interfaces.BlackoutRepository, holidayRepo repository_interfaces.HolidayRepository, recurringService service_interfaces.RecurringBookingService) *SlotGenerationJob {
+	return &SlotGenerationJob{
+		slotRepo:         slotRepo,
+		gameRepo:         gameRepo,
+		blackoutRepo:     blackoutRepo,
+		holidayRepo:      holidayRepo,
+		recurringService: recurringService,
+		trigger:          make(chan struct{}, 1),
+	}
+}
+
+func (j *SlotGenerationJob) Name() string {
+	return "slot generation"
+}
+
+// Run generates the slots of every game for the booking horizon. Slots that already exist are left untouched,
+// so running it again, or from several processes at once, never creates duplicates.
+func (j *SlotGenerationJob) Run(ctx context.Context) error {
+	slots, err := utils.InsertAllSlots(ctx, j.slotRepo, j.gameRepo, j.blackoutRepo, j.holidayRepo)
+	if err != nil {
+		return fmt.Errorf("failed to insert slots: %w", err)
+	}
+
+	if err := j.recurringService.MaterialiseRecurringBookings(ctx, slots); err != nil {
+		return fmt.Errorf("failed to materialise recurring bookings: %w", err)
+	}
+	return nil
+}
+
+// Trigger requests a generation run as soon as possible, e.g. after a game has been created.
+// Requests made while a run is already pending are merged into it.
+func (j *SlotGenerationJob) Trigger() {
+	select {
+	case j.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Triggers returns the channel on which the requested runs are signalled
+func (j *SlotGenerationJob) Triggers() <-chan struct{} {
+	return j.trigger
+}
@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
+	"time"
+)
+
+type blackoutRepo struct {
+	db *sql.DB
+}
+
+func NewBlackoutRepo(db *sql.DB) interfaces.BlackoutRepository {
+	return &blackoutRepo{db: db}
+}
+
+// CreateBlackout stores the blackout and, in the same transaction, removes the slots inside the window
+// together with their bookings. Slots that have already started are kept, so that the play history and
+// results are never lost. It returns the bookings that were cancelled.
+func (r *blackoutRepo) CreateBlackout(ctx context.Context, blackout *entities.Blackout) (uuid.UUID, []models.CancelledBooking, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	gameID := nullableUUID(blackout.GameID)
+
+	var id uuid.UUID
+	insertQuery := `INSERT INTO blackouts (game_id, start_time, end_time, reason) VALUES ($1, $2, $3, $4) RETURNING blackout_id`
+	err = tx.QueryRowContext(ctx, insertQuery, gameID, blackout.StartTime, blackout.EndTime, blackout.Reason).Scan(&id)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to create blackout: %w", err)
+	}
+
+	// Cancel the bookings of every upcoming slot overlapping the window
+	cancelQuery := `DELETE FROM bookings b 
+	                USING slots s, games g 
+	                WHERE b.slot_id = s.slot_id AND s.game_id = g.game_id 
+	                  AND s.start_time < $2 AND s.end_time > $1 AND s.start_time > NOW() 
+	                  AND ($3::uuid IS NULL OR s.game_id = $3) 
+	                RETURNING b.user_id, g.game_name, s.start_time`
+	rows, err := tx.QueryContext(ctx, cancelQuery, blackout.StartTime, blackout.EndTime, gameID)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to cancel bookings: %w", err)
+	}
+	var cancelled []models.CancelledBooking
+	for rows.Next() {
+		var booking models.CancelledBooking
+		if err := rows.Scan(&booking.UserId, &booking.GameName, &booking.StartTime); err != nil {
+			rows.Close()
+			return uuid.Nil, nil, fmt.Errorf("failed to scan cancelled booking: %w", err)
+		}
+		cancelled = append(cancelled, booking)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return uuid.Nil, nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	// Remove the slots themselves so that they can no longer be booked
+	deleteQuery := `DELETE FROM slots WHERE start_time < $2 AND end_time > $1 AND start_time > NOW() AND ($3::uuid IS NULL OR game_id = $3)`
+	if _, err := tx.ExecContext(ctx, deleteQuery, blackout.StartTime, blackout.EndTime, gameID); err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to delete slots: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to commit blackout: %w", err)
+	}
+	return id, cancelled, nil
+}
+
+// FetchUpcomingBlackouts retrieves the blackouts that have not ended yet, along with the game names.
+func (r *blackoutRepo) FetchUpcomingBlackouts(ctx context.Context) ([]models.Blackouts, error) {
+	query := `SELECT b.blackout_id, COALESCE(g.game_name, 'All games'), b.start_time, b.end_time, b.reason 
+	          FROM blackouts b 
+	          LEFT JOIN games g ON b.game_id = g.game_id 
+	          WHERE b.end_time > NOW() 
+	          ORDER BY b.start_time`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blackouts: %w", err)
+	}
+	defer rows.Close()
+
+	var blackouts []models.Blackouts
+	for rows.Next() {
+		var blackout models.Blackouts
+		if err := rows.Scan(&blackout.BlackoutId, &blackout.GameName, &blackout.StartTime, &blackout.EndTime, &blackout.Reason); err != nil {
+			return nil, fmt.Errorf("failed to scan blackout row: %w", err)
+		}
+		blackouts = append(blackouts, blackout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return blackouts, nil
+}
+
+// FetchBlackoutsBetween retrieves the blackouts overlapping the interval [from, to).
+func (r *blackoutRepo) FetchBlackoutsBetween(ctx context.Context, from, to time.Time) ([]entities.Blackout, error) {
+	query := `SELECT blackout_id, game_id, start_time, end_time, reason, created_at 
+	          FROM blackouts 
+	          WHERE start_time < $2 AND end_time > $1`
+	rows, err := r.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blackouts: %w", err)
+	}
+	defer rows.Close()
+
+	var blackouts []entities.Blackout
+	for rows.Next() {
+		var blackout entities.Blackout
+		var gameID uuid.NullUUID
+		if err := rows.Scan(&blackout.BlackoutID, &gameID, &blackout.StartTime, &blackout.EndTime, &blackout.Reason, &blackout.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan blackout row: %w", err)
+		}
+		if gameID.Valid {
+			blackout.GameID = &gameID.UUID
+		}
+		blackouts = append(blackouts, blackout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return blackouts, nil
+}
+
+// DeleteBlackout removes a blackout by its ID.
+func (r *blackoutRepo) DeleteBlackout(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM blackouts WHERE blackout_id = $1`
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete blackout: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no blackout found with ID %s", id)
+	}
+
+	return nil
+}
+
+// nullableUUID converts an optional ID into a value that is stored as NULL when missing
+func nullableUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
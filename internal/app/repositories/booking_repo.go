@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
 	interfaces "project2/internal/domain/interfaces/repository"
 	"project2/internal/models"
 	"time"
@@ -33,6 +35,195 @@ func (r *bookingRepo) CreateBooking(ctx context.Context, booking *entities.Booki
 	return id, nil
 }
 
+// CreateBookingWithinCapacity books the user into the slot as a single atomic operation.
+// The slot row is locked for the duration of the transaction, so concurrent bookings for
+// the same slot are serialised and the slot can never exceed maxPlayers.
+func (r *bookingRepo) CreateBookingWithinCapacity(ctx context.Context, booking *entities.Booking, maxPlayers int) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	id, err := insertBookingTx(ctx, tx, booking, maxPlayers)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit booking: %w", err)
+	}
+	return id, nil
+}
+
+// CreateGroupBooking books all the users into the slot in a single transaction.
+// Either every user gets a seat or none of the bookings are created.
+func (r *bookingRepo) CreateGroupBooking(ctx context.Context, slotID uuid.UUID, userIDs []uuid.UUID, maxPlayers int) ([]uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Take the slot lock first, as a single booking does, and then every member's lock in user ID order.
+	// Locking the members in the order they were entered could deadlock with another party sharing them.
+	slotLockQuery := `SELECT slot_id FROM slots WHERE slot_id = $1 FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, slotLockQuery, slotID); err != nil {
+		return nil, fmt.Errorf("failed to lock slot: %w", err)
+	}
+	userLockQuery := `SELECT user_id FROM users WHERE user_id = ANY($1) ORDER BY user_id FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, userLockQuery, pq.Array(userIDs)); err != nil {
+		return nil, fmt.Errorf("failed to lock users: %w", err)
+	}
+
+	bookingIDs := make([]uuid.UUID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		id, err := insertBookingTx(ctx, tx, &entities.Booking{SlotID: slotID, UserID: userID}, maxPlayers)
+		if err != nil {
+			return nil, &domain_errors.GroupMemberError{UserID: userID, Err: err}
+		}
+		bookingIDs = append(bookingIDs, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit group booking: %w", err)
+	}
+	return bookingIDs, nil
+}
+
+// insertBookingTx locks the slot, checks its capacity and the user's other bookings and inserts the booking inside tx.
+// The slot is marked as booked once the new booking fills it up.
+func insertBookingTx(ctx context.Context, tx *sql.Tx, booking *entities.Booking, maxPlayers int) (uuid.UUID, error) {
+	// Lock the slot row so that no other booking for this slot can run in parallel
+	var isBooked bool
+	lockQuery := `SELECT is_booked FROM slots WHERE slot_id = $1 FOR UPDATE`
+	err := tx.QueryRowContext(ctx, lockQuery, booking.SlotID).Scan(&isBooked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("no slot found with ID %s", booking.SlotID)
+		}
+		return uuid.Nil, fmt.Errorf("failed to lock slot: %w", err)
+	}
+
+	// Count the existing bookings and check whether the user is one of them
+	var bookedCount, userCount int
+	countQuery := `SELECT COUNT(*), COUNT(*) FILTER (WHERE user_id = $2) FROM bookings WHERE slot_id = $1`
+	err = tx.QueryRowContext(ctx, countQuery, booking.SlotID, booking.UserID).Scan(&bookedCount, &userCount)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to count slot bookings: %w", err)
+	}
+	if userCount > 0 {
+		return uuid.Nil, domain_errors.ErrAlreadyBooked
+	}
+	if isBooked || bookedCount >= maxPlayers {
+		return uuid.Nil, domain_errors.ErrSlotFull
+	}
+
+	// Lock the user so that two of their bookings cannot pass the overlap check in parallel
+	userLockQuery := `SELECT user_id FROM users WHERE user_id = $1 FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, userLockQuery, booking.UserID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	// Reject the booking if it overlaps any other booking of the user, whatever the game
+	if err := checkOverlapTx(ctx, tx, booking.UserID, booking.SlotID); err != nil {
+		return uuid.Nil, err
+	}
+
+	var id uuid.UUID
+	insertQuery := `INSERT INTO bookings (slot_id, user_id) VALUES ($1, $2) RETURNING booking_id`
+	err = tx.QueryRowContext(ctx, insertQuery, booking.SlotID, booking.UserID).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	// Mark the slot as booked if the max players are reached
+	if bookedCount+1 >= maxPlayers {
+		updateQuery := `UPDATE slots SET is_booked = TRUE WHERE slot_id = $1`
+		if _, err := tx.ExecContext(ctx, updateQuery, booking.SlotID); err != nil {
+			return uuid.Nil, fmt.Errorf("failed to update slot status: %w", err)
+		}
+	}
+
+	return id, nil
+}
+
+// checkOverlapTx returns a *domain_errors.BookingOverlapError if any booking of the user overlaps the slot
+func checkOverlapTx(ctx context.Context, tx *sql.Tx, userID, slotID uuid.UUID) error {
+	overlapQuery := `SELECT b.booking_id, g.game_name, s.start_time, s.end_time 
+	                 FROM bookings b 
+	                 JOIN slots s ON b.slot_id = s.slot_id 
+	                 JOIN games g ON s.game_id = g.game_id 
+	                 JOIN slots target ON target.slot_id = $2 
+	                 WHERE b.user_id = $1 AND s.start_time < target.end_time AND s.end_time > target.start_time 
+	                 ORDER BY s.start_time 
+	                 LIMIT 1`
+	var overlap domain_errors.BookingOverlapError
+	err := tx.QueryRowContext(ctx, overlapQuery, userID, slotID).Scan(&overlap.BookingID, &overlap.GameName, &overlap.StartTime, &overlap.EndTime)
+	if err == nil {
+		return &overlap
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to check overlapping bookings: %w", err)
+	}
+	return nil
+}
+
+// CancelBookingsBelowMinPlayers deletes every booking of the slot and reopens it when the slot has
+// at least one booking but fewer than minPlayers. The slot row is locked so that the check and the
+// cancellation cannot interleave with new bookings. It returns the users whose bookings were cancelled.
+func (r *bookingRepo) CancelBookingsBelowMinPlayers(ctx context.Context, slotID uuid.UUID, minPlayers int) ([]uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	lockQuery := `SELECT slot_id FROM slots WHERE slot_id = $1 FOR UPDATE`
+	if err := tx.QueryRowContext(ctx, lockQuery, slotID).Scan(&slotID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("no slot found with ID %s", slotID)
+		}
+		return nil, fmt.Errorf("failed to lock slot: %w", err)
+	}
+
+	var bookedCount int
+	countQuery := `SELECT COUNT(*) FROM bookings WHERE slot_id = $1`
+	if err := tx.QueryRowContext(ctx, countQuery, slotID).Scan(&bookedCount); err != nil {
+		return nil, fmt.Errorf("failed to count slot bookings: %w", err)
+	}
+	if bookedCount == 0 || bookedCount >= minPlayers {
+		return nil, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `DELETE FROM bookings WHERE slot_id = $1 RETURNING user_id`, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel slot bookings: %w", err)
+	}
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan cancelled booking: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE slots SET is_booked = FALSE WHERE slot_id = $1`, slotID); err != nil {
+		return nil, fmt.Errorf("failed to update slot status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit cancellation: %w", err)
+	}
+	return userIDs, nil
+}
+
 // FetchBookingByID retrieves a booking by its ID.
 func (r *bookingRepo) FetchBookingByID(ctx context.Context, id uuid.UUID) (*entities.Booking, error) {
 	query := `SELECT booking_id, slot_id, user_id, created_at FROM bookings WHERE booking_id = $1`
@@ -70,6 +261,219 @@ func (r *bookingRepo) DeleteBookingByID(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
+// CancelBookingAndPromote deletes a booking and, in the same transaction, books the first user
+// waiting for the slot into the freed seat. Waiters who have meanwhile booked an overlapping slot are skipped. It returns the ID of the promoted user, or uuid.Nil
+// if nobody was waiting.
+func (r *bookingRepo) CancelBookingAndPromote(ctx context.Context, bookingID uuid.UUID, maxPlayers int) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var slotID uuid.UUID
+	err = tx.QueryRowContext(ctx, `SELECT slot_id FROM bookings WHERE booking_id = $1`, bookingID).Scan(&slotID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("no booking found with ID %s", bookingID)
+		}
+		return uuid.Nil, fmt.Errorf("failed to fetch booking: %w", err)
+	}
+
+	// Lock the slot so that no booking or waitlist change can interleave with the promotion
+	if _, err := tx.ExecContext(ctx, `SELECT slot_id FROM slots WHERE slot_id = $1 FOR UPDATE`, slotID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to lock slot: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM bookings WHERE booking_id = $1`, bookingID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to delete booking: %w", err)
+	}
+
+	// A seat has just been freed, so the slot can no longer be full
+	if _, err := tx.ExecContext(ctx, `UPDATE slots SET is_booked = FALSE WHERE slot_id = $1`, slotID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to update slot status: %w", err)
+	}
+
+	promotedUserID, err := promoteFromWaitlistTx(ctx, tx, slotID, maxPlayers)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit cancellation: %w", err)
+	}
+	return promotedUserID, nil
+}
+
+// RescheduleBooking moves the booking to another slot in a single transaction: the seat in the new slot is
+// acquired with the same checks as a new booking and the old seat is released to the first user on its waitlist.
+// Both slots are locked in a fixed order so that two users swapping seats cannot deadlock. It returns the ID of
+// the new booking and of the user promoted into the old slot, or uuid.Nil if nobody was waiting.
+func (r *bookingRepo) RescheduleBooking(ctx context.Context, bookingID, newSlotID uuid.UUID, maxPlayers int) (uuid.UUID, uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var oldSlotID, userID uuid.UUID
+	err = tx.QueryRowContext(ctx, `SELECT slot_id, user_id FROM bookings WHERE booking_id = $1`, bookingID).Scan(&oldSlotID, &userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, uuid.Nil, fmt.Errorf("no booking found with ID %s", bookingID)
+		}
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to fetch booking: %w", err)
+	}
+	if oldSlotID == newSlotID {
+		return uuid.Nil, uuid.Nil, domain_errors.ErrAlreadyBooked
+	}
+
+	lockQuery := `SELECT slot_id FROM slots WHERE slot_id = ANY($1) ORDER BY slot_id FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, lockQuery, pq.Array([]uuid.UUID{oldSlotID, newSlotID})); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to lock slots: %w", err)
+	}
+
+	// Release the old seat first, so that it does not count as an overlap of the new one
+	if _, err := tx.ExecContext(ctx, `DELETE FROM bookings WHERE booking_id = $1`, bookingID); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to delete booking: %w", err)
+	}
+
+	newBookingID, err := insertBookingTx(ctx, tx, &entities.Booking{SlotID: newSlotID, UserID: userID}, maxPlayers)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM waitlist WHERE slot_id = $1 AND user_id = $2`, newSlotID, userID); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to update waitlist: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE slots SET is_booked = FALSE WHERE slot_id = $1`, oldSlotID); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to update slot status: %w", err)
+	}
+	promotedUserID, err := promoteFromWaitlistTx(ctx, tx, oldSlotID, maxPlayers)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to commit reschedule: %w", err)
+	}
+	return newBookingID, promotedUserID, nil
+}
+
+// promoteFromWaitlistTx books the first user waiting for the slot into a freed seat inside tx.
+// Waiters who have meanwhile booked the slot or an overlapping one are dropped from the queue.
+// It returns the ID of the promoted user, or uuid.Nil if nobody could be promoted.
+func promoteFromWaitlistTx(ctx context.Context, tx *sql.Tx, slotID uuid.UUID, maxPlayers int) (uuid.UUID, error) {
+	popQuery := `DELETE FROM waitlist 
+	             WHERE waitlist_id = (SELECT waitlist_id FROM waitlist WHERE slot_id = $1 ORDER BY created_at, waitlist_id LIMIT 1) 
+	             RETURNING user_id`
+	for {
+		var waiterID uuid.UUID
+		err := tx.QueryRowContext(ctx, popQuery, slotID).Scan(&waiterID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, nil
+		}
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to fetch waitlist: %w", err)
+		}
+
+		_, err = insertBookingTx(ctx, tx, &entities.Booking{SlotID: slotID, UserID: waiterID}, maxPlayers)
+		var overlap *domain_errors.BookingOverlapError
+		if errors.Is(err, domain_errors.ErrAlreadyBooked) || errors.As(err, &overlap) {
+			continue
+		}
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return waiterID, nil
+	}
+}
+
+// AddToWaitlist puts the user at the back of the waitlist of a full slot.
+func (r *bookingRepo) AddToWaitlist(ctx context.Context, entry *entities.WaitlistEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the slot so that a cancellation cannot free a seat while the user joins the queue
+	var isBooked bool
+	err = tx.QueryRowContext(ctx, `SELECT is_booked FROM slots WHERE slot_id = $1 FOR UPDATE`, entry.SlotID).Scan(&isBooked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no slot found with ID %s", entry.SlotID)
+		}
+		return fmt.Errorf("failed to lock slot: %w", err)
+	}
+	if !isBooked {
+		return domain_errors.ErrSlotNotFull
+	}
+
+	var userCount int
+	countQuery := `SELECT COUNT(*) FROM bookings WHERE slot_id = $1 AND user_id = $2`
+	if err := tx.QueryRowContext(ctx, countQuery, entry.SlotID, entry.UserID).Scan(&userCount); err != nil {
+		return fmt.Errorf("failed to check existing booking: %w", err)
+	}
+	if userCount > 0 {
+		return domain_errors.ErrAlreadyBooked
+	}
+
+	insertQuery := `INSERT INTO waitlist (slot_id, user_id) VALUES ($1, $2) ON CONFLICT (slot_id, user_id) DO NOTHING`
+	result, err := tx.ExecContext(ctx, insertQuery, entry.SlotID, entry.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to join waitlist: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain_errors.ErrAlreadyWaitlisted
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit waitlist entry: %w", err)
+	}
+	return nil
+}
+
+// RemoveFromWaitlist takes the user out of the waitlist of a slot.
+func (r *bookingRepo) RemoveFromWaitlist(ctx context.Context, slotID, userID uuid.UUID) error {
+	query := `DELETE FROM waitlist WHERE slot_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, slotID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to leave waitlist: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user is not on the waitlist for this slot")
+	}
+
+	return nil
+}
+
+// FetchWaitlistPosition returns the 1-based position of the user in the waitlist of a slot
+// (0 if the user is not waiting) together with the total number of users waiting.
+func (r *bookingRepo) FetchWaitlistPosition(ctx context.Context, slotID, userID uuid.UUID) (int, int, error) {
+	query := `SELECT COALESCE(MAX(position) FILTER (WHERE user_id = $2), 0), COUNT(*) 
+	          FROM (
+	              SELECT user_id, ROW_NUMBER() OVER (ORDER BY created_at, waitlist_id) AS position 
+	              FROM waitlist WHERE slot_id = $1
+	          ) queue`
+
+	var position, size int
+	if err := r.db.QueryRowContext(ctx, query, slotID, userID).Scan(&position, &size); err != nil {
+		return 0, 0, fmt.Errorf("failed to fetch waitlist position: %w", err)
+	}
+	return position, size, nil
+}
+
 // FetchBookingsByUserID retrieves all bookings associated with a specific user ID.
 func (r *bookingRepo) FetchBookingsByUserID(ctx context.Context, userID uuid.UUID) ([]entities.Booking, error) {
 	query := `SELECT booking_id, slot_id, user_id,result, created_at FROM bookings WHERE user_id = $1`
@@ -130,15 +534,19 @@ func (r *bookingRepo) FetchBookingsBySlotID(ctx context.Context, slotID uuid.UUI
 	return bookings, nil
 }
 
+// FetchUpcomingBookingsByUserID retrieves all bookings of the user whose slot has not started yet.
 func (r *bookingRepo) FetchUpcomingBookingsByUserID(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error) {
 	// SQL query to join bookings, slots, and games tables and filter by user ID and future slot start time
 	query := `
 		SELECT 
+			b.booking_id,
 			g.game_name, 
 			s.slot_id,
+			s.instance,
 			s.slot_date AS date, 
 			s.start_time AS start_time, 
-			s.end_time AS end_time
+			s.end_time AS end_time,
+			b.check_in_code
 		FROM bookings b
 		JOIN slots s ON b.slot_id = s.slot_id
 		JOIN games g ON s.game_id = g.game_id
@@ -155,16 +563,15 @@ func (r *bookingRepo) FetchUpcomingBookingsByUserID(ctx context.Context, userID
 	var bookings []models.Bookings
 	for rows.Next() {
 		var booking models.Bookings
-		var slotID uuid.UUID
-		err := rows.Scan(&booking.GameName, &slotID, &booking.Date, &booking.StartTime, &booking.EndTime)
+		err := rows.Scan(&booking.BookingId, &booking.GameName, &booking.SlotId, &booking.Instance, &booking.Date, &booking.StartTime, &booking.EndTime, &booking.CheckInCode)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan booking: %w", err)
 		}
 
 		// Fetch booked users for the slot
-		bookedUsers, err := r.FetchSlotBookedUsers(ctx, slotID)
+		bookedUsers, err := r.FetchSlotBookedUsers(ctx, booking.SlotId)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch booked users for slot %s: %w", slotID, err)
+			return nil, fmt.Errorf("failed to fetch booked users for slot %s: %w", booking.SlotId, err)
 		}
 		booking.BookedUsers = bookedUsers
 
@@ -178,7 +585,7 @@ func (r *bookingRepo) FetchUpcomingBookingsByUserID(ctx context.Context, userID
 	return bookings, nil
 }
 
-// UpdateBookingResult updates the result (win/loss) of the specified booking
+// UpdateBookingResult updates the result (win/loss/draw) of the specified booking
 func (r *bookingRepo) UpdateBookingResult(ctx context.Context, bookingId uuid.UUID, result string) error {
 	// Define the SQL query to update the result
 	query := `
@@ -346,3 +753,154 @@ func (r *bookingRepo) FetchBookingBySlotAndUserId(ctx context.Context, slotId uu
 
 	return booking, nil
 }
+
+// FetchBookingCountsBySlotIDs returns the number of bookings of each of the given slots.
+// Slots without any booking are not present in the returned map.
+func (r *bookingRepo) FetchBookingCountsBySlotIDs(ctx context.Context, slotIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	query := `
+		SELECT slot_id, COUNT(*)
+		FROM bookings
+		WHERE slot_id = ANY($1)
+		GROUP BY slot_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(slotIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch slot booking counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var slotID uuid.UUID
+		var count int
+		if err := rows.Scan(&slotID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan slot booking count: %w", err)
+		}
+		counts[slotID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// CheckInBooking checks the user in to their booking with the given code, provided its slot starts between from and to.
+// It returns ErrInvalidCheckInCode if no such booking is waiting for check-in.
+func (r *bookingRepo) CheckInBooking(ctx context.Context, userID uuid.UUID, code string, from, to time.Time) (uuid.UUID, error) {
+	query := `UPDATE bookings b 
+	          SET attendance = 'checked_in', checked_in_at = NOW() 
+	          FROM slots s 
+	          WHERE b.slot_id = s.slot_id AND b.user_id = $1 AND b.check_in_code = $2 
+	            AND b.attendance = 'pending' AND s.start_time BETWEEN $3 AND $4 
+	          RETURNING b.booking_id`
+	var id uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, userID, code, from, to).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, domain_errors.ErrInvalidCheckInCode
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to check in: %w", err)
+	}
+	return id, nil
+}
+
+// MarkNoShows marks the bookings of slots that started between the given times and were never checked in as no-shows.
+// It returns the bookings that were marked.
+func (r *bookingRepo) MarkNoShows(ctx context.Context, startedAfter, startedBefore time.Time) ([]models.NoShow, error) {
+	query := `UPDATE bookings b 
+	          SET attendance = 'no_show' 
+	          FROM slots s, games g 
+	          WHERE b.slot_id = s.slot_id AND s.game_id = g.game_id 
+	            AND b.attendance = 'pending' AND s.start_time >= $1 AND s.start_time < $2 
+	          RETURNING b.user_id, g.game_name, s.start_time`
+	rows, err := r.db.QueryContext(ctx, query, startedAfter, startedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark no-shows: %w", err)
+	}
+	defer rows.Close()
+
+	var noShows []models.NoShow
+	for rows.Next() {
+		var noShow models.NoShow
+		if err := rows.Scan(&noShow.UserId, &noShow.GameName, &noShow.StartTime); err != nil {
+			return nil, fmt.Errorf("failed to scan no-show: %w", err)
+		}
+		noShows = append(noShows, noShow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return noShows, nil
+}
+
+// FetchAttendanceStats counts the check-ins and no-shows of the user.
+func (r *bookingRepo) FetchAttendanceStats(ctx context.Context, userID uuid.UUID) (models.AttendanceStats, error) {
+	query := `SELECT COUNT(*) FILTER (WHERE attendance = 'checked_in'), COUNT(*) FILTER (WHERE attendance = 'no_show') 
+	          FROM bookings WHERE user_id = $1`
+	var stats models.AttendanceStats
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&stats.CheckIns, &stats.NoShows); err != nil {
+		return models.AttendanceStats{}, fmt.Errorf("failed to fetch attendance stats: %w", err)
+	}
+	return stats, nil
+}
+
+// TransferBooking hands the booking over to another user in a single transaction. The slot and the recipient are
+// locked, and the transfer is rejected if the recipient is already in the slot or has an overlapping booking.
+// The recipient is removed from the slot's waitlist and gets a fresh check-in code.
+func (r *bookingRepo) TransferBooking(ctx context.Context, bookingID, toUserID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var slotID uuid.UUID
+	err = tx.QueryRowContext(ctx, `SELECT slot_id FROM bookings WHERE booking_id = $1`, bookingID).Scan(&slotID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no booking found with ID %s", bookingID)
+		}
+		return fmt.Errorf("failed to fetch booking: %w", err)
+	}
+
+	// Lock the slot and then the recipient, in the same order as new bookings do
+	if _, err := tx.ExecContext(ctx, `SELECT slot_id FROM slots WHERE slot_id = $1 FOR UPDATE`, slotID); err != nil {
+		return fmt.Errorf("failed to lock slot: %w", err)
+	}
+
+	var userCount int
+	countQuery := `SELECT COUNT(*) FROM bookings WHERE slot_id = $1 AND user_id = $2`
+	if err := tx.QueryRowContext(ctx, countQuery, slotID, toUserID).Scan(&userCount); err != nil {
+		return fmt.Errorf("failed to check existing booking: %w", err)
+	}
+	if userCount > 0 {
+		return domain_errors.ErrAlreadyBooked
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT user_id FROM users WHERE user_id = $1 FOR UPDATE`, toUserID); err != nil {
+		return fmt.Errorf("failed to lock user: %w", err)
+	}
+	if err := checkOverlapTx(ctx, tx, toUserID, slotID); err != nil {
+		return err
+	}
+
+	updateQuery := `UPDATE bookings 
+	                SET user_id = $2, attendance = 'pending', check_in_code = lpad(floor(random() * 1000000)::int::text, 6, '0') 
+	                WHERE booking_id = $1`
+	if _, err := tx.ExecContext(ctx, updateQuery, bookingID, toUserID); err != nil {
+		return fmt.Errorf("failed to transfer booking: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM waitlist WHERE slot_id = $1 AND user_id = $2`, slotID, toUserID); err != nil {
+		return fmt.Errorf("failed to update waitlist: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transfer: %w", err)
+	}
+	return nil
+}
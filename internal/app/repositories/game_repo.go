@@ -3,11 +3,13 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"project2/internal/domain/entities"
 	interfaces "project2/internal/domain/interfaces/repository"
+	"time"
 )
 
 type gameRepo struct {
@@ -22,11 +24,12 @@ func NewGameRepo(db *sql.DB) interfaces.GameRepository {
 
 // FetchGameByID retrieves a game by its ID.
 func (r *gameRepo) FetchGameByID(ctx context.Context, id uuid.UUID) (*entities.Game, error) {
-	query := `SELECT game_id, game_name, min_players, max_players, instances, is_active, created_at, updated_at FROM games WHERE game_id = $1`
+	query := `SELECT game_id, game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy, created_at, updated_at FROM games WHERE game_id = $1`
 	row := r.db.QueryRowContext(ctx, query, id)
 
 	var game entities.Game
-	err := row.Scan(&game.GameID, &game.GameName, &game.MinPlayers, &game.MaxPlayers, &game.Instances, &game.IsActive, &game.CreatedAt, &game.UpdatedAt)
+	var weekdaySchedules []byte
+	err := row.Scan(&game.GameID, &game.GameName, &game.MinPlayers, &game.MaxPlayers, &game.Instances, &game.OpenTime, &game.CloseTime, &game.SlotDuration, &weekdaySchedules, &game.IsActive, &game.ScoringStrategy, &game.CreatedAt, &game.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil // No game found
@@ -34,12 +37,16 @@ func (r *gameRepo) FetchGameByID(ctx context.Context, id uuid.UUID) (*entities.G
 		return nil, fmt.Errorf("failed to fetch game by ID: %w", err)
 	}
 
+	if game.WeekdaySchedules, err = decodeWeekdaySchedules(weekdaySchedules); err != nil {
+		return nil, err
+	}
+
 	return &game, nil
 }
 
 // FetchAllGames retrieves all games from the database.
 func (r *gameRepo) FetchAllGames(ctx context.Context) ([]entities.Game, error) {
-	query := `SELECT game_id, game_name, min_players, max_players, instances, is_active, created_at, updated_at FROM games`
+	query := `SELECT game_id, game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy, created_at, updated_at FROM games`
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch all games: %w", err)
@@ -49,9 +56,13 @@ func (r *gameRepo) FetchAllGames(ctx context.Context) ([]entities.Game, error) {
 	var games []entities.Game
 	for rows.Next() {
 		var game entities.Game
-		if err := rows.Scan(&game.GameID, &game.GameName, &game.MinPlayers, &game.MaxPlayers, &game.Instances, &game.IsActive, &game.CreatedAt, &game.UpdatedAt); err != nil {
+		var weekdaySchedules []byte
+		if err := rows.Scan(&game.GameID, &game.GameName, &game.MinPlayers, &game.MaxPlayers, &game.Instances, &game.OpenTime, &game.CloseTime, &game.SlotDuration, &weekdaySchedules, &game.IsActive, &game.ScoringStrategy, &game.CreatedAt, &game.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan game row: %w", err)
 		}
+		if game.WeekdaySchedules, err = decodeWeekdaySchedules(weekdaySchedules); err != nil {
+			return nil, err
+		}
 		games = append(games, game)
 	}
 
@@ -64,9 +75,14 @@ func (r *gameRepo) FetchAllGames(ctx context.Context) ([]entities.Game, error) {
 
 // CreateGame inserts a new game into the database and returns the created game ID.
 func (r *gameRepo) CreateGame(ctx context.Context, game *entities.Game) (uuid.UUID, error) {
-	query := `INSERT INTO games (game_name, min_players, max_players, instances, is_active) VALUES ($1, $2, $3, $4, $5) RETURNING game_id`
+	weekdaySchedules, err := encodeWeekdaySchedules(game.WeekdaySchedules)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	query := `INSERT INTO games (game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING game_id`
 	var id uuid.UUID
-	err := r.db.QueryRowContext(ctx, query, game.GameName, game.MinPlayers, game.MaxPlayers, game.Instances, game.IsActive).Scan(&id)
+	err = r.db.QueryRowContext(ctx, query, game.GameName, game.MinPlayers, game.MaxPlayers, game.Instances, game.OpenTime, game.CloseTime, game.SlotDuration, weekdaySchedules, game.IsActive, game.ScoringStrategy).Scan(&id)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to create game: %w", err)
 	}
@@ -104,3 +120,74 @@ func (r *gameRepo) UpdateGameStatus(ctx context.Context, gameID uuid.UUID, statu
 	}
 	return nil
 }
+
+// UpdateGameSchedule updates the operating hours, slot duration and weekday overrides of a game. The upcoming
+// slots of the days on which nobody booked the game yet are removed, so that they can be generated again
+// with the new schedule. Days with bookings keep their slots.
+func (r *gameRepo) UpdateGameSchedule(ctx context.Context, game *entities.Game) error {
+	weekdaySchedules, err := encodeWeekdaySchedules(game.WeekdaySchedules)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE games SET open_time = $1, close_time = $2, slot_duration = $3, weekday_schedules = $4, updated_at = CURRENT_TIMESTAMP WHERE game_id = $5`
+	_, err = tx.ExecContext(ctx, query, game.OpenTime, game.CloseTime, game.SlotDuration, weekdaySchedules, game.GameID)
+	if err != nil {
+		return fmt.Errorf("failed to update game schedule: %w", err)
+	}
+
+	deleteQuery := `DELETE FROM slots s 
+	                WHERE s.game_id = $1 AND s.start_time > NOW() 
+	                  AND NOT EXISTS (
+	                      SELECT 1 FROM bookings b 
+	                      JOIN slots booked ON booked.slot_id = b.slot_id 
+	                      WHERE booked.game_id = s.game_id AND booked.slot_date = s.slot_date)`
+	if _, err := tx.ExecContext(ctx, deleteQuery, game.GameID); err != nil {
+		return fmt.Errorf("failed to delete slots of the old schedule: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit game schedule: %w", err)
+	}
+	return nil
+}
+
+// UpdateScoringStrategy changes how the leaderboard of a game is scored
+func (r *gameRepo) UpdateScoringStrategy(ctx context.Context, gameID uuid.UUID, strategy string) error {
+	query := `UPDATE games SET scoring_strategy = $1, updated_at = CURRENT_TIMESTAMP WHERE game_id = $2`
+	_, err := r.db.ExecContext(ctx, query, strategy, gameID)
+	if err != nil {
+		return fmt.Errorf("failed to update scoring strategy: %w", err)
+	}
+	return nil
+}
+
+// encodeWeekdaySchedules converts the weekday overrides to JSON, storing NULL when there are none
+func encodeWeekdaySchedules(schedules map[time.Weekday]entities.GameSchedule) (sql.NullString, error) {
+	if len(schedules) == 0 {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(schedules)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode weekday schedules: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeWeekdaySchedules parses the weekday overrides stored as JSON
+func decodeWeekdaySchedules(data []byte) (map[time.Weekday]entities.GameSchedule, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var schedules map[time.Weekday]entities.GameSchedule
+	if err := json.Unmarshal(data, &schedules); err != nil {
+		return nil, fmt.Errorf("failed to decode weekday schedules: %w", err)
+	}
+	return schedules, nil
+}
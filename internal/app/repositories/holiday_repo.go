@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"project2/internal/domain/entities"
+	interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
+	"time"
+)
+
+type holidayRepo struct {
+	db *sql.DB
+}
+
+func NewHolidayRepo(db *sql.DB) interfaces.HolidayRepository {
+	return &holidayRepo{db: db}
+}
+
+// CreateHolidays stores the holidays in a single transaction, renaming the ones that already exist.
+// The upcoming slots on those dates that nobody booked are removed. Booked slots are kept, and their
+// bookings are returned so that the players can be told about the closure.
+func (r *holidayRepo) CreateHolidays(ctx context.Context, holidays []entities.Holiday) ([]models.HolidayBooking, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO holidays (holiday_date, name) VALUES ($1, $2) 
+	          ON CONFLICT (holiday_date) DO UPDATE SET name = EXCLUDED.name`
+	deleteQuery := `DELETE FROM slots s 
+	                WHERE s.slot_date = $1 AND s.start_time > NOW() 
+	                  AND NOT EXISTS (SELECT 1 FROM bookings b WHERE b.slot_id = s.slot_id)`
+	bookingsQuery := `SELECT b.user_id, g.game_name, s.start_time 
+	                  FROM bookings b 
+	                  JOIN slots s ON b.slot_id = s.slot_id 
+	                  JOIN games g ON s.game_id = g.game_id 
+	                  WHERE s.slot_date = $1 AND s.start_time > NOW() 
+	                  ORDER BY s.start_time`
+
+	var booked []models.HolidayBooking
+	for _, holiday := range holidays {
+		date := holiday.Date.Format("2006-01-02")
+		if _, err := tx.ExecContext(ctx, query, date, holiday.Name); err != nil {
+			return nil, fmt.Errorf("failed to create holiday on %s: %w", date, err)
+		}
+		if _, err := tx.ExecContext(ctx, deleteQuery, date); err != nil {
+			return nil, fmt.Errorf("failed to delete slots on %s: %w", date, err)
+		}
+
+		rows, err := tx.QueryContext(ctx, bookingsQuery, date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch bookings on %s: %w", date, err)
+		}
+		for rows.Next() {
+			booking := models.HolidayBooking{HolidayName: holiday.Name}
+			if err := rows.Scan(&booking.UserId, &booking.GameName, &booking.StartTime); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan booking: %w", err)
+			}
+			booked = append(booked, booking)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("rows iteration error: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit holidays: %w", err)
+	}
+	return booked, nil
+}
+
+// FetchUpcomingHolidays retrieves the holidays from today onwards.
+func (r *holidayRepo) FetchUpcomingHolidays(ctx context.Context) ([]entities.Holiday, error) {
+	query := `SELECT holiday_date, name, created_at FROM holidays WHERE holiday_date >= CURRENT_DATE ORDER BY holiday_date`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch holidays: %w", err)
+	}
+	return scanHolidays(rows)
+}
+
+// FetchHolidaysBetween retrieves the holidays falling between the two dates, both inclusive.
+func (r *holidayRepo) FetchHolidaysBetween(ctx context.Context, from, to time.Time) ([]entities.Holiday, error) {
+	query := `SELECT holiday_date, name, created_at FROM holidays WHERE holiday_date BETWEEN $1 AND $2 ORDER BY holiday_date`
+	rows, err := r.db.QueryContext(ctx, query, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch holidays: %w", err)
+	}
+	return scanHolidays(rows)
+}
+
+// DeleteHoliday removes the holiday on the given date.
+func (r *holidayRepo) DeleteHoliday(ctx context.Context, date time.Time) error {
+	query := `DELETE FROM holidays WHERE holiday_date = $1`
+	result, err := r.db.ExecContext(ctx, query, date.Format("2006-01-02"))
+	if err != nil {
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no holiday found on %s", date.Format("2006-01-02"))
+	}
+
+	return nil
+}
+
+// scanHolidays reads every row of a holidays query and closes the rows
+func scanHolidays(rows *sql.Rows) ([]entities.Holiday, error) {
+	defer rows.Close()
+
+	var holidays []entities.Holiday
+	for rows.Next() {
+		var holiday entities.Holiday
+		if err := rows.Scan(&holiday.Date, &holiday.Name, &holiday.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan holiday row: %w", err)
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return holidays, nil
+}
@@ -20,16 +20,17 @@ func NewLeaderboardRepo(db *sql.DB) interfaces.LeaderboardRepository {
 }
 
 // FetchGameLeaderboard fetches the game leaderboard of a particular game
-// It returns the list based on descending order of score
-func (r *leaderboardRepo) FetchGameLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.Leaderboard, error) {
+// It returns the list based on descending order of score, as given by the scoring strategy of the game, with ties
+// broken by rating. Players with fewer than provisionalGames games are flagged as provisional.
+func (r *leaderboardRepo) FetchGameLeaderboard(ctx context.Context, gameID uuid.UUID, provisionalGames int) ([]models.Leaderboard, error) {
 	query := `
-		SELECT u.username, l.score
+		SELECT u.username, l.score, l.rating, l.rating_deviation, l.wins + l.losses + l.draws, l.wins + l.losses + l.draws < $2
 		FROM leaderboard l
 		INNER JOIN users u ON l.user_id = u.user_id
 		WHERE l.game_id = $1
-		ORDER BY l.score DESC
+		ORDER BY l.score DESC, l.rating DESC
 	`
-	rows, err := r.db.QueryContext(ctx, query, gameID)
+	rows, err := r.db.QueryContext(ctx, query, gameID, provisionalGames)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch game leaderboard: %w", err)
 	}
@@ -38,7 +39,7 @@ func (r *leaderboardRepo) FetchGameLeaderboard(ctx context.Context, gameID uuid.
 	var leaderboard []models.Leaderboard
 	for rows.Next() {
 		var entry models.Leaderboard
-		if err := rows.Scan(&entry.UserName, &entry.Score); err != nil {
+		if err := rows.Scan(&entry.UserName, &entry.Score, &entry.Rating, &entry.RatingDeviation, &entry.GamesPlayed, &entry.Provisional); err != nil {
 			return nil, fmt.Errorf("failed to scan leaderboard row: %w", err)
 		}
 		leaderboard = append(leaderboard, entry)
@@ -53,11 +54,11 @@ func (r *leaderboardRepo) FetchGameLeaderboard(ctx context.Context, gameID uuid.
 
 // FetchUserGameStats retrieves a user's stats for a specific game.
 func (r *leaderboardRepo) FetchUserGameStats(ctx context.Context, userID, gameID uuid.UUID) (*entities.Leaderboard, error) {
-	query := `SELECT score_id, user_id, game_id, wins, losses, score, created_at FROM leaderboard WHERE user_id = $1 AND game_id = $2`
+	query := `SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE user_id = $1 AND game_id = $2`
 	row := r.db.QueryRowContext(ctx, query, userID, gameID)
 
 	var stats entities.Leaderboard
-	err := row.Scan(&stats.ScoreID, &stats.UserID, &stats.GameID, &stats.Wins, &stats.Losses, &stats.Score, &stats.CreatedAt)
+	err := row.Scan(&stats.ScoreID, &stats.UserID, &stats.GameID, &stats.Wins, &stats.Losses, &stats.Draws, &stats.Score, &stats.Rating, &stats.RatingDeviation, &stats.Volatility, &stats.CreatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil // No stats found for this user and game
@@ -70,7 +71,7 @@ func (r *leaderboardRepo) FetchUserGameStats(ctx context.Context, userID, gameID
 
 // FetchUserOverallStats retrieves a user's overall stats across all games.
 func (r *leaderboardRepo) FetchUserOverallStats(ctx context.Context, userID uuid.UUID) ([]entities.Leaderboard, error) {
-	query := `SELECT score_id, user_id, game_id, wins, losses, score, created_at FROM leaderboard WHERE user_id = $1 ORDER BY score DESC`
+	query := `SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE user_id = $1 ORDER BY score DESC`
 	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user overall stats: %w", err)
@@ -80,7 +81,7 @@ func (r *leaderboardRepo) FetchUserOverallStats(ctx context.Context, userID uuid
 	var stats []entities.Leaderboard
 	for rows.Next() {
 		var entry entities.Leaderboard
-		if err := rows.Scan(&entry.ScoreID, &entry.UserID, &entry.GameID, &entry.Wins, &entry.Losses, &entry.Score, &entry.CreatedAt); err != nil {
+		if err := rows.Scan(&entry.ScoreID, &entry.UserID, &entry.GameID, &entry.Wins, &entry.Losses, &entry.Draws, &entry.Score, &entry.Rating, &entry.RatingDeviation, &entry.Volatility, &entry.CreatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan stats row: %w", err)
 		}
 		stats = append(stats, entry)
@@ -108,20 +109,22 @@ func (r *leaderboardRepo) UpdateUserGameStats(ctx context.Context, leaderboard *
 		// Update existing entry
 		updateQuery := `
 			UPDATE leaderboard 
-			SET wins = $1, losses = $2, score = $3 
-			WHERE score_id = $4
+			SET wins = $1, losses = $2, draws = $3, score = $4, rating = $5, rating_deviation = $6, volatility = $7 
+			WHERE score_id = $8
 		`
-		_, err = r.db.ExecContext(ctx, updateQuery, leaderboard.Wins, leaderboard.Losses, leaderboard.Score, leaderboard.ScoreID)
+		_, err = r.db.ExecContext(ctx, updateQuery, leaderboard.Wins, leaderboard.Losses, leaderboard.Draws, leaderboard.Score,
+			leaderboard.Rating, leaderboard.RatingDeviation, leaderboard.Volatility, leaderboard.ScoreID)
 		if err != nil {
 			return fmt.Errorf("failed to update user game stats: %w", err)
 		}
 	} else {
 		// Insert new entry
 		insertQuery := `
-			INSERT INTO leaderboard (score_id, user_id, game_id, wins, losses, score)
-			VALUES ($1, $2, $3, $4, $5, $6)
+			INSERT INTO leaderboard (score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		`
-		_, err = r.db.ExecContext(ctx, insertQuery, leaderboard.ScoreID, leaderboard.UserID, leaderboard.GameID, leaderboard.Wins, leaderboard.Losses, leaderboard.Score)
+		_, err = r.db.ExecContext(ctx, insertQuery, leaderboard.ScoreID, leaderboard.UserID, leaderboard.GameID, leaderboard.Wins, leaderboard.Losses, leaderboard.Draws, leaderboard.Score,
+			leaderboard.Rating, leaderboard.RatingDeviation, leaderboard.Volatility)
 		if err != nil {
 			return fmt.Errorf("failed to insert user game stats: %w", err)
 		}
@@ -129,3 +132,120 @@ func (r *leaderboardRepo) UpdateUserGameStats(ctx context.Context, leaderboard *
 
 	return nil
 }
+
+// saveUserGameStatsTx inserts or updates a user's stats for a game within the transaction. A user has a single
+// entry per game, so a new entry for a user already on the leaderboard updates the existing one.
+func saveUserGameStatsTx(ctx context.Context, tx *sql.Tx, leaderboard *entities.Leaderboard) error {
+	query := `
+		INSERT INTO leaderboard (score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (user_id, game_id) DO UPDATE 
+		SET wins = EXCLUDED.wins, losses = EXCLUDED.losses, draws = EXCLUDED.draws, score = EXCLUDED.score, 
+		    rating = EXCLUDED.rating, rating_deviation = EXCLUDED.rating_deviation, volatility = EXCLUDED.volatility
+	`
+	_, err := tx.ExecContext(ctx, query, leaderboard.ScoreID, leaderboard.UserID, leaderboard.GameID, leaderboard.Wins, leaderboard.Losses, leaderboard.Draws, leaderboard.Score,
+		leaderboard.Rating, leaderboard.RatingDeviation, leaderboard.Volatility)
+	if err != nil {
+		return fmt.Errorf("failed to save user game stats: %w", err)
+	}
+	return nil
+}
+
+// gameStatsQuery selects the stats of every player of a game, highest score first, as scanned by scanGameStats
+const gameStatsQuery = `SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE game_id = $1 ORDER BY score DESC, created_at`
+
+// FetchGameStats retrieves the stats of every player of a game, highest score first.
+func (r *leaderboardRepo) FetchGameStats(ctx context.Context, gameID uuid.UUID) ([]*entities.Leaderboard, error) {
+	rows, err := r.db.QueryContext(ctx, gameStatsQuery, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game stats: %w", err)
+	}
+	return scanGameStats(rows)
+}
+
+// fetchGameStatsForUpdateTx retrieves the stats of every player of a game within the transaction and locks them
+// until it ends
+func fetchGameStatsForUpdateTx(ctx context.Context, tx *sql.Tx, gameID uuid.UUID) ([]*entities.Leaderboard, error) {
+	rows, err := tx.QueryContext(ctx, gameStatsQuery+` FOR UPDATE`, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game stats: %w", err)
+	}
+	return scanGameStats(rows)
+}
+
+func scanGameStats(rows *sql.Rows) ([]*entities.Leaderboard, error) {
+	defer rows.Close()
+
+	var stats []*entities.Leaderboard
+	for rows.Next() {
+		var entry entities.Leaderboard
+		if err := rows.Scan(&entry.ScoreID, &entry.UserID, &entry.GameID, &entry.Wins, &entry.Losses, &entry.Draws, &entry.Score, &entry.Rating, &entry.RatingDeviation, &entry.Volatility, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan stats row: %w", err)
+		}
+		stats = append(stats, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// FetchMatchHistory returns the players of every match of a game counted in its leaderboard, in the order the
+// matches were counted.
+func (r *leaderboardRepo) FetchMatchHistory(ctx context.Context, gameID uuid.UUID) ([][]entities.MatchPlayer, error) {
+	query := `
+		SELECT mp.match_id, mp.user_id, mp.booking_id, mp.side, mp.outcome
+		FROM matches m
+		INNER JOIN slots s ON m.slot_id = s.slot_id
+		INNER JOIN match_players mp ON mp.match_id = m.match_id
+		WHERE s.game_id = $1 AND m.applied_at IS NOT NULL
+		ORDER BY m.applied_at, m.match_id, mp.side, mp.user_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch match history: %w", err)
+	}
+	defer rows.Close()
+
+	var history [][]entities.MatchPlayer
+	for rows.Next() {
+		var player entities.MatchPlayer
+		if err := rows.Scan(&player.MatchID, &player.UserID, &player.BookingID, &player.Side, &player.Outcome); err != nil {
+			return nil, fmt.Errorf("failed to scan match player: %w", err)
+		}
+		if last := len(history) - 1; last >= 0 && history[last][0].MatchID == player.MatchID {
+			history[last] = append(history[last], player)
+		} else {
+			history = append(history, []entities.MatchPlayer{player})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over match history: %w", err)
+	}
+
+	return history, nil
+}
+
+// UpdateScores saves the scores of the given leaderboard entries in a single transaction.
+func (r *leaderboardRepo) UpdateScores(ctx context.Context, leaderboard []*entities.Leaderboard) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE leaderboard SET score = $1 WHERE score_id = $2`
+	for _, entry := range leaderboard {
+		if _, err := tx.ExecContext(ctx, query, entry.Score, entry.ScoreID); err != nil {
+			return fmt.Errorf("failed to update score: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
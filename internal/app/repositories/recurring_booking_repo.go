@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"project2/internal/domain/entities"
+	interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
+	"time"
+)
+
+type recurringBookingRepo struct {
+	db *sql.DB
+}
+
+func NewRecurringBookingRepo(db *sql.DB) interfaces.RecurringBookingRepository {
+	return &recurringBookingRepo{db: db}
+}
+
+// CreateRecurringBooking inserts a new recurring booking rule and returns its ID.
+func (r *recurringBookingRepo) CreateRecurringBooking(ctx context.Context, recurrence *entities.RecurringBooking) (uuid.UUID, error) {
+	query := `INSERT INTO recurring_bookings (user_id, game_id, weekdays, start_time, end_date) 
+	          VALUES ($1, $2, $3, $4, $5) RETURNING recurrence_id`
+	var id uuid.UUID
+	err := r.db.QueryRowContext(ctx, query, recurrence.UserID, recurrence.GameID, pq.Array(encodeWeekdays(recurrence.Weekdays)),
+		recurrence.StartTime, recurrence.EndDate.Format("2006-01-02")).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create recurring booking: %w", err)
+	}
+	return id, nil
+}
+
+// FetchRecurringBookingsByUserID retrieves all recurring bookings of a user along with the game names.
+func (r *recurringBookingRepo) FetchRecurringBookingsByUserID(ctx context.Context, userID uuid.UUID) ([]models.RecurringBookings, error) {
+	query := `SELECT r.recurrence_id, g.game_name, r.weekdays, r.start_time, r.end_date 
+	          FROM recurring_bookings r 
+	          JOIN games g ON r.game_id = g.game_id 
+	          WHERE r.user_id = $1 
+	          ORDER BY g.game_name, r.start_time`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recurring bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var recurrences []models.RecurringBookings
+	for rows.Next() {
+		var recurrence models.RecurringBookings
+		var weekdays []int64
+		if err := rows.Scan(&recurrence.RecurrenceId, &recurrence.GameName, pq.Array(&weekdays), &recurrence.StartTime, &recurrence.EndDate); err != nil {
+			return nil, fmt.Errorf("failed to scan recurring booking row: %w", err)
+		}
+		recurrence.Weekdays = decodeWeekdays(weekdays)
+		recurrences = append(recurrences, recurrence)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return recurrences, nil
+}
+
+// FetchActiveRecurringBookingsByGameID retrieves the recurring bookings of a game that are still running on the given date.
+func (r *recurringBookingRepo) FetchActiveRecurringBookingsByGameID(ctx context.Context, gameID uuid.UUID, date time.Time) ([]entities.RecurringBooking, error) {
+	query := `SELECT recurrence_id, user_id, game_id, weekdays, start_time, end_date, created_at 
+	          FROM recurring_bookings 
+	          WHERE game_id = $1 AND end_date >= $2 
+	          ORDER BY created_at`
+	rows, err := r.db.QueryContext(ctx, query, gameID, date.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recurring bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var recurrences []entities.RecurringBooking
+	for rows.Next() {
+		var recurrence entities.RecurringBooking
+		var weekdays []int64
+		if err := rows.Scan(&recurrence.RecurrenceID, &recurrence.UserID, &recurrence.GameID, pq.Array(&weekdays),
+			&recurrence.StartTime, &recurrence.EndDate, &recurrence.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan recurring booking row: %w", err)
+		}
+		recurrence.Weekdays = decodeWeekdays(weekdays)
+		recurrences = append(recurrences, recurrence)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return recurrences, nil
+}
+
+// DeleteRecurringBooking removes a recurring booking owned by the user.
+func (r *recurringBookingRepo) DeleteRecurringBooking(ctx context.Context, recurrenceID uuid.UUID, userID uuid.UUID) error {
+	query := `DELETE FROM recurring_bookings WHERE recurrence_id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, recurrenceID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete recurring booking: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no recurring booking found with ID %s", recurrenceID)
+	}
+
+	return nil
+}
+
+// encodeWeekdays converts the weekdays into the integer array stored in the database
+func encodeWeekdays(weekdays []time.Weekday) []int64 {
+	values := make([]int64, len(weekdays))
+	for i, weekday := range weekdays {
+		values[i] = int64(weekday)
+	}
+	return values
+}
+
+// decodeWeekdays converts the integer array stored in the database back into weekdays
+func decodeWeekdays(values []int64) []time.Weekday {
+	weekdays := make([]time.Weekday, len(values))
+	for i, value := range values {
+		weekdays[i] = time.Weekday(value)
+	}
+	return weekdays
+}
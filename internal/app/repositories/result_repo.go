@@ -0,0 +1,495 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
+	"time"
+)
+
+type resultRepo struct {
+	db *sql.DB
+}
+
+func NewResultRepo(db *sql.DB) interfaces.ResultRepository {
+	return &resultRepo{db: db}
+}
+
+// resultReportQuery selects the columns scanned by scanResultReports
+const resultReportQuery = `SELECT m.match_id, m.slot_id, g.game_name, s.start_time, r.username, m.confirm_by, 
+	       ARRAY(SELECT u.username FROM result_confirmations d JOIN users u ON d.user_id = u.user_id 
+	             WHERE d.match_id = m.match_id AND d.status = 'disputed' ORDER BY u.username) 
+	FROM matches m 
+	JOIN slots s ON m.slot_id = s.slot_id 
+	JOIN games g ON s.game_id = g.game_id 
+	JOIN users r ON m.reported_by = r.user_id `
+
+// CreateMatch stores the reported match and its players together with a pending confirmation for every other
+// player. The reporter's own confirmation is recorded straight away. Only one match can be reported per slot.
+func (r *resultRepo) CreateMatch(ctx context.Context, match *entities.Match) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id uuid.UUID
+	insertQuery := `INSERT INTO matches (slot_id, reported_by, confirm_by) VALUES ($1, $2, $3) 
+	                ON CONFLICT (slot_id) DO NOTHING RETURNING match_id`
+	err = tx.QueryRowContext(ctx, insertQuery, match.SlotID, match.ReportedBy, match.ConfirmBy).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, domain_errors.ErrResultAlreadyReported
+		}
+		return uuid.Nil, fmt.Errorf("failed to create match: %w", err)
+	}
+
+	if err := insertMatchPlayersTx(ctx, tx, id, match.Players); err != nil {
+		return uuid.Nil, err
+	}
+
+	confirmationsQuery := `INSERT INTO result_confirmations (match_id, user_id, status, responded_at) 
+	                       SELECT $1, user_id, 
+	                              CASE WHEN user_id = $2 THEN 'confirmed' ELSE 'pending' END, 
+	                              CASE WHEN user_id = $2 THEN NOW() END 
+	                       FROM match_players WHERE match_id = $1`
+	if _, err := tx.ExecContext(ctx, confirmationsQuery, id, match.ReportedBy); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create result confirmations: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit match: %w", err)
+	}
+	return id, nil
+}
+
+// insertMatchPlayersTx stores the outcome of every player of the match
+func insertMatchPlayersTx(ctx context.Context, tx *sql.Tx, matchID uuid.UUID, players []entities.MatchPlayer) error {
+	query := `INSERT INTO match_players (match_id, user_id, booking_id, side, outcome, score) VALUES ($1, $2, $3, $4, $5, $6)`
+	for _, player := range players {
+		_, err := tx.ExecContext(ctx, query, matchID, player.UserID, player.BookingID, player.Side, player.Outcome, nullableInt(player.Score))
+		if err != nil {
+			return fmt.Errorf("failed to store outcome of user %s: %w", player.UserID, err)
+		}
+	}
+	return nil
+}
+
+// FetchMatchByID returns the match together with its players, ordered by side
+func (r *resultRepo) FetchMatchByID(ctx context.Context, matchID uuid.UUID) (*entities.Match, error) {
+	query := `SELECT match_id, slot_id, reported_by, status, confirm_by, resolved_at, applied_at, created_at 
+	          FROM matches WHERE match_id = $1`
+
+	var match entities.Match
+	err := r.db.QueryRowContext(ctx, query, matchID).Scan(&match.MatchID, &match.SlotID, &match.ReportedBy,
+		&match.Status, &match.ConfirmBy, &match.ResolvedAt, &match.AppliedAt, &match.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch match: %w", err)
+	}
+
+	playersQuery := `SELECT user_id, booking_id, side, outcome, score FROM match_players 
+	                 WHERE match_id = $1 ORDER BY side, user_id`
+	rows, err := r.db.QueryContext(ctx, playersQuery, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch match players: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		player := entities.MatchPlayer{MatchID: matchID}
+		var score sql.NullInt64
+		if err := rows.Scan(&player.UserID, &player.BookingID, &player.Side, &player.Outcome, &score); err != nil {
+			return nil, fmt.Errorf("failed to scan match player: %w", err)
+		}
+		player.Score = intPointer(score)
+		match.Players = append(match.Players, player)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return &match, nil
+}
+
+// FetchUnreportedMatches returns the user's bookings of matches that ended before the given time, were played
+// by more than one player and have no reported result yet. The players of each match are listed in BookedUsers.
+func (r *resultRepo) FetchUnreportedMatches(ctx context.Context, userID uuid.UUID, endedBefore time.Time) ([]models.Bookings, error) {
+	query := `SELECT b.booking_id, s.slot_id, g.game_name, s.instance, s.slot_date, s.start_time, s.end_time, 
+	                 ARRAY(SELECT u.username FROM bookings o JOIN users u ON o.user_id = u.user_id 
+	                       WHERE o.slot_id = s.slot_id ORDER BY u.username) 
+	          FROM bookings b 
+	          JOIN slots s ON b.slot_id = s.slot_id 
+	          JOIN games g ON s.game_id = g.game_id 
+	          WHERE b.user_id = $1 AND s.end_time < $2 AND b.result = 'pending' 
+	            AND NOT EXISTS (SELECT 1 FROM matches m WHERE m.slot_id = s.slot_id) 
+	            AND (SELECT COUNT(*) FROM bookings o WHERE o.slot_id = s.slot_id) > 1 
+	          ORDER BY s.end_time DESC`
+	rows, err := r.db.QueryContext(ctx, query, userID, endedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch unreported matches: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []models.Bookings
+	for rows.Next() {
+		var booking models.Bookings
+		err := rows.Scan(&booking.BookingId, &booking.SlotId, &booking.GameName, &booking.Instance, &booking.Date,
+			&booking.StartTime, &booking.EndTime, pq.Array(&booking.BookedUsers))
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan match: %w", err)
+		}
+		bookings = append(bookings, booking)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return bookings, nil
+}
+
+// FetchMatchParticipants returns the players booked into the slot, ordered by username
+func (r *resultRepo) FetchMatchParticipants(ctx context.Context, slotID uuid.UUID) ([]models.MatchParticipant, error) {
+	query := `SELECT b.user_id, b.booking_id, u.username 
+	          FROM bookings b JOIN users u ON b.user_id = u.user_id 
+	          WHERE b.slot_id = $1 ORDER BY u.username`
+	rows, err := r.db.QueryContext(ctx, query, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch match participants: %w", err)
+	}
+	defer rows.Close()
+
+	var participants []models.MatchParticipant
+	for rows.Next() {
+		var participant models.MatchParticipant
+		if err := rows.Scan(&participant.UserId, &participant.BookingId, &participant.Username); err != nil {
+			return nil, fmt.Errorf("failed to scan match participant: %w", err)
+		}
+		participants = append(participants, participant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return participants, nil
+}
+
+// FetchResultsAwaitingConfirmation returns the pending results the user has not confirmed or disputed yet
+func (r *resultRepo) FetchResultsAwaitingConfirmation(ctx context.Context, userID uuid.UUID) ([]models.ResultReport, error) {
+	query := resultReportQuery + `JOIN result_confirmations c ON c.match_id = m.match_id 
+	          WHERE c.user_id = $1 AND c.status = 'pending' AND m.status = 'pending' 
+	          ORDER BY m.confirm_by`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch results awaiting confirmation: %w", err)
+	}
+	reports, err := scanResultReports(rows)
+	if err != nil {
+		return nil, err
+	}
+	return r.withPlayerResults(ctx, reports)
+}
+
+// FetchDisputedResults returns every disputed result, oldest match first
+func (r *resultRepo) FetchDisputedResults(ctx context.Context) ([]models.ResultReport, error) {
+	query := resultReportQuery + `WHERE m.status = 'disputed' ORDER BY s.start_time`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch disputed results: %w", err)
+	}
+	reports, err := scanResultReports(rows)
+	if err != nil {
+		return nil, err
+	}
+	return r.withPlayerResults(ctx, reports)
+}
+
+func scanResultReports(rows *sql.Rows) ([]models.ResultReport, error) {
+	defer rows.Close()
+
+	var reports []models.ResultReport
+	for rows.Next() {
+		var report models.ResultReport
+		err := rows.Scan(&report.MatchId, &report.SlotId, &report.GameName, &report.StartTime, &report.ReportedBy,
+			&report.ConfirmBy, pq.Array(&report.DisputedBy))
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan result: %w", err)
+		}
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return reports, nil
+}
+
+// withPlayerResults fills in the outcome of every player of the reported matches
+func (r *resultRepo) withPlayerResults(ctx context.Context, reports []models.ResultReport) ([]models.ResultReport, error) {
+	query := `SELECT u.username, p.side, p.outcome, p.score 
+	          FROM match_players p JOIN users u ON p.user_id = u.user_id 
+	          WHERE p.match_id = $1 ORDER BY p.side, u.username`
+	for i := range reports {
+		rows, err := r.db.QueryContext(ctx, query, reports[i].MatchId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch match players: %w", err)
+		}
+		for rows.Next() {
+			var player models.MatchPlayerResult
+			var score sql.NullInt64
+			if err := rows.Scan(&player.Username, &player.Side, &player.Outcome, &score); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan match player: %w", err)
+			}
+			player.Score = intPointer(score)
+			reports[i].Players = append(reports[i].Players, player)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("rows iteration error: %w", err)
+		}
+	}
+	return reports, nil
+}
+
+// RespondToResult records the user's confirmation or dispute of a pending result and returns the resulting status
+// of the result. A single dispute marks the whole result as disputed. The result is confirmed once no player's
+// response is pending anymore.
+func (r *resultRepo) RespondToResult(ctx context.Context, matchID, userID uuid.UUID, confirmed bool) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM matches WHERE match_id = $1 FOR UPDATE`, matchID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("no match found with ID %s", matchID)
+		}
+		return "", fmt.Errorf("failed to fetch match: %w", err)
+	}
+	if status != entities.ResultPending {
+		return "", domain_errors.ErrNoResultToConfirm
+	}
+
+	response := entities.ResultConfirmed
+	if !confirmed {
+		response = entities.ResultDisputed
+	}
+	respondQuery := `UPDATE result_confirmations SET status = $3, responded_at = NOW() 
+	                 WHERE match_id = $1 AND user_id = $2 AND status = 'pending'`
+	res, err := tx.ExecContext(ctx, respondQuery, matchID, userID, response)
+	if err != nil {
+		return "", fmt.Errorf("failed to record response: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return "", domain_errors.ErrNoResultToConfirm
+	}
+
+	updateQuery := `UPDATE matches SET status = 'disputed' WHERE match_id = $1`
+	if confirmed {
+		var pending int
+		pendingQuery := `SELECT COUNT(*) FROM result_confirmations WHERE match_id = $1 AND status = 'pending'`
+		if err := tx.QueryRowContext(ctx, pendingQuery, matchID).Scan(&pending); err != nil {
+			return "", fmt.Errorf("failed to count pending confirmations: %w", err)
+		}
+		if pending > 0 {
+			response = entities.ResultPending
+		}
+		updateQuery = `UPDATE matches SET status = 'confirmed', resolved_at = NOW() WHERE match_id = $1`
+	}
+
+	if response != entities.ResultPending {
+		if _, err := tx.ExecContext(ctx, updateQuery, matchID); err != nil {
+			return "", fmt.Errorf("failed to update match: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit response: %w", err)
+	}
+	return response, nil
+}
+
+// ResolveDispute confirms a disputed match with the outcomes decided by an admin, replacing the reported ones
+func (r *resultRepo) ResolveDispute(ctx context.Context, matchID uuid.UUID, players []entities.MatchPlayer) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE matches SET status = 'confirmed', resolved_at = NOW() WHERE match_id = $1 AND status = 'disputed'`
+	res, err := tx.ExecContext(ctx, query, matchID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve dispute: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain_errors.ErrResultNotDisputed
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM match_players WHERE match_id = $1`, matchID); err != nil {
+		return fmt.Errorf("failed to remove reported outcomes: %w", err)
+	}
+	if err := insertMatchPlayersTx(ctx, tx, matchID, players); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit dispute resolution: %w", err)
+	}
+	return nil
+}
+
+// ConfirmExpiredResults confirms the pending matches whose confirmation deadline passed before the given time
+// and returns how many were confirmed
+func (r *resultRepo) ConfirmExpiredResults(ctx context.Context, deadline time.Time) (int, error) {
+	query := `UPDATE matches SET status = 'confirmed', resolved_at = NOW() WHERE status = 'pending' AND confirm_by < $1`
+	res, err := r.db.ExecContext(ctx, query, deadline)
+	if err != nil {
+		return 0, fmt.Errorf("failed to confirm expired results: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return int(rowsAffected), nil
+}
+
+// FetchUnappliedMatchIDs returns the confirmed matches that are not counted in the leaderboard yet, in the order
+// they were confirmed
+func (r *resultRepo) FetchUnappliedMatchIDs(ctx context.Context) ([]uuid.UUID, error) {
+	query := `SELECT match_id FROM matches WHERE status = 'confirmed' AND applied_at IS NULL ORDER BY resolved_at, match_id`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch unapplied matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matchIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan match ID: %w", err)
+		}
+		matchIDs = append(matchIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return matchIDs, nil
+}
+
+// ApplyMatch counts a confirmed match of the game in the leaderboard in a single transaction. The game is locked
+// and the stats of its players and its teams are read and locked within the transaction, so matches of the same
+// game are applied one after the other, each from the stats saved by the one before. scoreStandings and rateTeams
+// derive the leaderboard entries and teams the match changes from them. ApplyMatch saves those, records every
+// player's outcome as the result of their booking and marks the match as applied. A match that is not confirmed or
+// was already applied is left untouched and ErrResultAlreadyApplied is returned.
+func (r *resultRepo) ApplyMatch(ctx context.Context, matchID, gameID uuid.UUID, scoreStandings interfaces.StandingsScorer, rateTeams interfaces.TeamRater) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Marking the match first locks it, so a concurrent apply of the same match waits and then finds it applied
+	query := `UPDATE matches SET applied_at = NOW() WHERE match_id = $1 AND status = 'confirmed' AND applied_at IS NULL`
+	res, err := tx.ExecContext(ctx, query, matchID)
+	if err != nil {
+		return fmt.Errorf("failed to mark match as applied: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain_errors.ErrResultAlreadyApplied
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT game_id FROM games WHERE game_id = $1 FOR UPDATE`, gameID); err != nil {
+		return fmt.Errorf("failed to lock game: %w", err)
+	}
+	current, err := fetchGameStatsForUpdateTx(ctx, tx, gameID)
+	if err != nil {
+		return err
+	}
+	standings, err := scoreStandings(current)
+	if err != nil {
+		return err
+	}
+	currentTeams, err := fetchGameTeamsForUpdateTx(ctx, tx, gameID)
+	if err != nil {
+		return err
+	}
+	teams, err := rateTeams(currentTeams)
+	if err != nil {
+		return err
+	}
+
+	for _, standing := range standings {
+		if err := saveUserGameStatsTx(ctx, tx, standing); err != nil {
+			return err
+		}
+	}
+	for _, team := range teams {
+		if err := updateTeamStatsTx(ctx, tx, team); err != nil {
+			return err
+		}
+	}
+
+	resultQuery := `UPDATE bookings b SET result = p.outcome FROM match_players p 
+	                WHERE p.match_id = $1 AND b.booking_id = p.booking_id`
+	if _, err := tx.ExecContext(ctx, resultQuery, matchID); err != nil {
+		return fmt.Errorf("failed to update booking results: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit match: %w", err)
+	}
+	return nil
+}
+
+// nullableInt converts an optional number into a value that is stored as NULL when missing
+func nullableInt(value *int) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*value), Valid: true}
+}
+
+// intPointer converts a nullable number read from the database into an optional one
+func intPointer(value sql.NullInt64) *int {
+	if !value.Valid {
+		return nil
+	}
+	number := int(value.Int64)
+	return &number
+}
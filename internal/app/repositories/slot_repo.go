@@ -6,8 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"project2/internal/domain/entities"
 	interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
 	"time"
 )
 
@@ -23,11 +25,11 @@ func NewSlotRepo(db *sql.DB) interfaces.SlotRepository {
 
 // FetchSlotByID retrieves a slot by its ID.
 func (r *slotRepo) FetchSlotByID(ctx context.Context, id uuid.UUID) (*entities.Slot, error) {
-	query := `SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_id = $1`
+	query := `SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_id = $1`
 	row := r.db.QueryRowContext(ctx, query, id)
 
 	var slot entities.Slot
-	err := row.Scan(&slot.SlotID, &slot.GameID, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt)
+	err := row.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil // No slot found
@@ -39,10 +41,15 @@ func (r *slotRepo) FetchSlotByID(ctx context.Context, id uuid.UUID) (*entities.S
 }
 
 // CreateSlot inserts a new slot into the database and returns the created slot ID.
+// If the slot already exists nothing is inserted and uuid.Nil is returned.
 func (r *slotRepo) CreateSlot(ctx context.Context, slot *entities.Slot) (uuid.UUID, error) {
-	query := `INSERT INTO slots (game_id, slot_date, start_time, end_time, is_booked) VALUES ($1, $2, $3, $4, $5) RETURNING slot_id`
+	query := `INSERT INTO slots (game_id, instance, slot_date, start_time, end_time, is_booked) VALUES ($1, $2, $3, $4, $5, $6) 
+	          ON CONFLICT (game_id, start_time, instance) DO NOTHING RETURNING slot_id`
 	var id uuid.UUID
-	err := r.db.QueryRowContext(ctx, query, slot.GameID, slot.Date, slot.StartTime, slot.EndTime, slot.IsBooked).Scan(&id)
+	err := r.db.QueryRowContext(ctx, query, slot.GameID, slot.Instance, slot.Date, slot.StartTime, slot.EndTime, slot.IsBooked).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, nil
+	}
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to create slot: %w", err)
 	}
@@ -72,7 +79,7 @@ func (r *slotRepo) DeleteSlotByID(ctx context.Context, id uuid.UUID) error {
 // FetchSlotsByDate retrieves all slots for a specific date.
 func (r *slotRepo) FetchSlotsByDate(ctx context.Context, date time.Time) ([]entities.Slot, error) {
 	dateStr := date.Format("2006-01-02")
-	query := `SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = $1`
+	query := `SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = $1`
 	rows, err := r.db.QueryContext(ctx, query, dateStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch slots by date: %w", err)
@@ -82,7 +89,7 @@ func (r *slotRepo) FetchSlotsByDate(ctx context.Context, date time.Time) ([]enti
 	var slots []entities.Slot
 	for rows.Next() {
 		var slot entities.Slot
-		if err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt); err != nil {
+		if err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan slot row: %w", err)
 		}
 		slots = append(slots, slot)
@@ -98,11 +105,11 @@ func (r *slotRepo) FetchSlotsByDate(ctx context.Context, date time.Time) ([]enti
 // FetchSlotByDateAndTime retrieves a slot by its date and start time.
 func (r *slotRepo) FetchSlotByDateAndTime(ctx context.Context, date time.Time, startTime time.Time) (*entities.Slot, error) {
 	dateStr := date.Format("2006-01-02")
-	query := `SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = $1 AND start_time = $2`
+	query := `SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = $1 AND start_time = $2`
 	row := r.db.QueryRowContext(ctx, query, dateStr, startTime)
 
 	var slot entities.Slot
-	err := row.Scan(&slot.SlotID, &slot.GameID, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt)
+	err := row.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil // No slot found
@@ -115,7 +122,7 @@ func (r *slotRepo) FetchSlotByDateAndTime(ctx context.Context, date time.Time, s
 
 // FetchSlotsByGameID retrieves all slots associated with a specific game ID.
 func (r *slotRepo) FetchSlotsByGameID(ctx context.Context, gameID uuid.UUID) ([]entities.Slot, error) {
-	query := `SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE game_id = $1`
+	query := `SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE game_id = $1`
 	rows, err := r.db.QueryContext(ctx, query, gameID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch slots by game ID: %w", err)
@@ -125,7 +132,7 @@ func (r *slotRepo) FetchSlotsByGameID(ctx context.Context, gameID uuid.UUID) ([]
 	var slots []entities.Slot
 	for rows.Next() {
 		var slot entities.Slot
-		if err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt); err != nil {
+		if err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan slot row: %w", err)
 		}
 		slots = append(slots, slot)
@@ -143,9 +150,10 @@ func (r *slotRepo) FetchSlotsByGameIDAndDate(ctx context.Context, gameID uuid.UU
 	// Convert the Go date to a string in the format YYYY-MM-DD for PostgresSQL comparison
 	dateStr := date.Format("2006-01-02")
 
-	query := `SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at 
+	query := `SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at 
 	          FROM slots 
-	          WHERE game_id = $1 AND slot_date::date = $2`
+	          WHERE game_id = $1 AND slot_date::date = $2
+	          ORDER BY start_time, instance`
 
 	rows, err := r.db.QueryContext(ctx, query, gameID, dateStr)
 	if err != nil {
@@ -156,7 +164,7 @@ func (r *slotRepo) FetchSlotsByGameIDAndDate(ctx context.Context, gameID uuid.UU
 	var slots []entities.Slot
 	for rows.Next() {
 		var slot entities.Slot
-		err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt)
+		err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan slot: %w", err)
 		}
@@ -170,6 +178,136 @@ func (r *slotRepo) FetchSlotsByGameIDAndDate(ctx context.Context, gameID uuid.UU
 	return slots, nil
 }
 
+// FetchSlotOccupancyByGameIDAndDate retrieves all slots for a specific game on a given date, together with
+// their number of bookings and the game's capacity.
+func (r *slotRepo) FetchSlotOccupancyByGameIDAndDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error) {
+	query := `SELECT s.slot_id, s.game_id, s.instance, s.slot_date, s.start_time, s.end_time, s.is_booked, s.created_at, 
+	                 COUNT(b.booking_id), g.max_players 
+	          FROM slots s 
+	          JOIN games g ON s.game_id = g.game_id 
+	          LEFT JOIN bookings b ON b.slot_id = s.slot_id 
+	          WHERE s.game_id = $1 AND s.slot_date::date = $2 
+	          GROUP BY s.slot_id, g.max_players 
+	          ORDER BY s.start_time, s.instance`
+
+	rows, err := r.db.QueryContext(ctx, query, gameID, date.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query slot occupancy by game ID and date: %w", err)
+	}
+	return scanSlotOccupancy(rows)
+}
+
+// FetchSlotOccupancyByDate retrieves the slots of every game on a given date, together with their number of
+// bookings and the game's capacity.
+func (r *slotRepo) FetchSlotOccupancyByDate(ctx context.Context, date time.Time) ([]models.SlotOccupancy, error) {
+	query := `SELECT s.slot_id, s.game_id, s.instance, s.slot_date, s.start_time, s.end_time, s.is_booked, s.created_at, 
+	                 COUNT(b.booking_id), g.max_players 
+	          FROM slots s 
+	          JOIN games g ON s.game_id = g.game_id 
+	          LEFT JOIN bookings b ON b.slot_id = s.slot_id 
+	          WHERE s.slot_date::date = $1 
+	          GROUP BY s.slot_id, g.max_players 
+	          ORDER BY s.start_time, s.instance`
+
+	rows, err := r.db.QueryContext(ctx, query, date.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query slot occupancy by date: %w", err)
+	}
+	return scanSlotOccupancy(rows)
+}
+
+// scanSlotOccupancy reads the slots with their booked count and capacity, and closes the rows
+func scanSlotOccupancy(rows *sql.Rows) ([]models.SlotOccupancy, error) {
+	defer rows.Close()
+
+	var slots []models.SlotOccupancy
+	for rows.Next() {
+		var slot models.SlotOccupancy
+		err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt,
+			&slot.BookedCount, &slot.Capacity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan slot occupancy: %w", err)
+		}
+		slots = append(slots, slot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return slots, nil
+}
+
+// FetchSlotsStartingBetween retrieves all slots whose start time lies in the interval (from, to].
+func (r *slotRepo) FetchSlotsStartingBetween(ctx context.Context, from, to time.Time) ([]entities.Slot, error) {
+	query := `SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at 
+	          FROM slots 
+	          WHERE start_time > $1 AND start_time <= $2
+	          ORDER BY start_time`
+	rows, err := r.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch slots starting between %s and %s: %w", from, to, err)
+	}
+	defer rows.Close()
+
+	var slots []entities.Slot
+	for rows.Next() {
+		var slot entities.Slot
+		if err := rows.Scan(&slot.SlotID, &slot.GameID, &slot.Instance, &slot.Date, &slot.StartTime, &slot.EndTime, &slot.IsBooked, &slot.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan slot row: %w", err)
+		}
+		slots = append(slots, slot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over slots: %w", err)
+	}
+
+	return slots, nil
+}
+
+// FetchFreeSlots retrieves the soonest slots of active games that start in the interval [EarliestStart, LatestStart]
+// and have at least SeatsNeeded free seats. Only the games in GameIDs are searched, unless it is empty.
+// Slots on holidays are left out, since the game room is closed on those days.
+func (r *slotRepo) FetchFreeSlots(ctx context.Context, search models.SlotSearch) ([]models.FreeSlot, error) {
+	query := `SELECT s.slot_id, g.game_id, g.game_name, s.instance, s.start_time, s.end_time, g.max_players - COUNT(b.booking_id) AS free_seats 
+	          FROM slots s 
+	          JOIN games g ON s.game_id = g.game_id 
+	          LEFT JOIN bookings b ON b.slot_id = s.slot_id 
+	          WHERE g.is_active AND NOT s.is_booked 
+	            AND s.start_time >= $1 AND s.start_time <= $2 
+	            AND (cardinality($3::uuid[]) = 0 OR s.game_id = ANY($3)) 
+	            AND NOT EXISTS (SELECT 1 FROM holidays h WHERE h.holiday_date = s.slot_date) 
+	          GROUP BY s.slot_id, g.game_id 
+	          HAVING g.max_players - COUNT(b.booking_id) >= $4 
+	          ORDER BY s.start_time, g.game_name, s.instance 
+	          LIMIT $5`
+	gameIDs := search.GameIDs
+	if gameIDs == nil {
+		gameIDs = []uuid.UUID{}
+	}
+	rows, err := r.db.QueryContext(ctx, query, search.EarliestStart, search.LatestStart, pq.Array(gameIDs), search.SeatsNeeded, search.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search free slots: %w", err)
+	}
+	defer rows.Close()
+
+	var slots []models.FreeSlot
+	for rows.Next() {
+		var slot models.FreeSlot
+		if err := rows.Scan(&slot.SlotId, &slot.GameId, &slot.GameName, &slot.Instance, &slot.StartTime, &slot.EndTime, &slot.FreeSeats); err != nil {
+			return nil, fmt.Errorf("failed to scan free slot: %w", err)
+		}
+		slots = append(slots, slot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return slots, nil
+}
+
 // UpdateSlotStatus updates the booking status of a specific slot.
 func (r *slotRepo) UpdateSlotStatus(ctx context.Context, slotID uuid.UUID, isBooked bool) error {
 	// Define the SQL query to update the is_booked status of the slot
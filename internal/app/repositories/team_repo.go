@@ -0,0 +1,219 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
+)
+
+type teamRepo struct {
+	db *sql.DB
+}
+
+func NewTeamRepo(db *sql.DB) interfaces.TeamRepository {
+	return &teamRepo{db: db}
+}
+
+// teamNameIndex keeps the names of the teams of a game unique regardless of case
+const teamNameIndex = "teams_game_name_key"
+
+// CreateTeam inserts the team together with its members and returns the created team ID.
+// A team whose name is already taken in the game, in any case, is rejected with ErrTeamNameTaken.
+func (r *teamRepo) CreateTeam(ctx context.Context, team *entities.Team) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO teams (game_id, name, created_by, rating, rating_deviation, volatility) VALUES ($1, $2, $3, $4, $5, $6) RETURNING team_id`
+	var teamID uuid.UUID
+	err = tx.QueryRowContext(ctx, query, team.GameID, team.Name, team.CreatedBy, team.Rating, team.RatingDeviation, team.Volatility).Scan(&teamID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == teamNameIndex {
+			return uuid.Nil, domain_errors.ErrTeamNameTaken
+		}
+		return uuid.Nil, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	memberQuery := `INSERT INTO team_members (team_id, user_id) VALUES ($1, $2)`
+	for _, member := range team.Members {
+		if _, err := tx.ExecContext(ctx, memberQuery, teamID, member); err != nil {
+			return uuid.Nil, fmt.Errorf("failed to add team member: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return teamID, nil
+}
+
+// gameTeamsQuery selects every team of a game together with its members, as scanned by scanGameTeams
+const gameTeamsQuery = `
+		SELECT t.team_id, t.game_id, t.name, t.wins, t.losses, t.draws, t.rating, t.rating_deviation, t.volatility, t.created_at,
+		       ARRAY_AGG(tm.user_id::text)
+		FROM teams t
+		INNER JOIN team_members tm ON tm.team_id = t.team_id
+		WHERE t.game_id = $1
+		GROUP BY t.team_id
+		ORDER BY t.created_at
+	`
+
+// FetchGameTeams returns every team of the game together with its members.
+func (r *teamRepo) FetchGameTeams(ctx context.Context, gameID uuid.UUID) ([]entities.Team, error) {
+	rows, err := r.db.QueryContext(ctx, gameTeamsQuery, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game teams: %w", err)
+	}
+	return scanGameTeams(rows)
+}
+
+// fetchGameTeamsForUpdateTx returns every team of the game together with its members within the transaction and
+// locks the teams until it ends. Rows cannot be locked by a grouped query, so they are locked first.
+func fetchGameTeamsForUpdateTx(ctx context.Context, tx *sql.Tx, gameID uuid.UUID) ([]entities.Team, error) {
+	lockQuery := `SELECT team_id FROM teams WHERE game_id = $1 ORDER BY team_id FOR UPDATE`
+	if _, err := tx.ExecContext(ctx, lockQuery, gameID); err != nil {
+		return nil, fmt.Errorf("failed to lock game teams: %w", err)
+	}
+	rows, err := tx.QueryContext(ctx, gameTeamsQuery, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game teams: %w", err)
+	}
+	return scanGameTeams(rows)
+}
+
+func scanGameTeams(rows *sql.Rows) ([]entities.Team, error) {
+	defer rows.Close()
+
+	var teams []entities.Team
+	for rows.Next() {
+		var team entities.Team
+		var members []string
+		if err := rows.Scan(&team.TeamID, &team.GameID, &team.Name, &team.Wins, &team.Losses, &team.Draws,
+			&team.Rating, &team.RatingDeviation, &team.Volatility, &team.CreatedAt, pq.Array(&members)); err != nil {
+			return nil, fmt.Errorf("failed to scan team row: %w", err)
+		}
+		for _, member := range members {
+			memberID, err := uuid.Parse(member)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse team member: %w", err)
+			}
+			team.Members = append(team.Members, memberID)
+		}
+		teams = append(teams, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// FetchUserTeams returns the teams the user is a member of, with the usernames of all their members.
+func (r *teamRepo) FetchUserTeams(ctx context.Context, userID uuid.UUID) ([]models.Team, error) {
+	query := `
+		SELECT t.team_id, t.name, g.game_name, ARRAY_AGG(u.username ORDER BY u.username), t.wins, t.draws, t.losses, t.rating
+		FROM teams t
+		INNER JOIN games g ON g.game_id = t.game_id
+		INNER JOIN team_members tm ON tm.team_id = t.team_id
+		INNER JOIN users u ON u.user_id = tm.user_id
+		WHERE t.team_id IN (SELECT team_id FROM team_members WHERE user_id = $1)
+		GROUP BY t.team_id, g.game_name
+		ORDER BY g.game_name, t.name
+	`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		var team models.Team
+		if err := rows.Scan(&team.TeamId, &team.Name, &team.GameName, pq.Array(&team.Members), &team.Wins, &team.Draws, &team.Losses, &team.Rating); err != nil {
+			return nil, fmt.Errorf("failed to scan team row: %w", err)
+		}
+		teams = append(teams, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// FetchTeamLeaderboard returns the teams of the game that have played at least one match, highest rating first.
+func (r *teamRepo) FetchTeamLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.TeamLeaderboard, error) {
+	query := `
+		SELECT t.name, ARRAY_AGG(u.username ORDER BY u.username), t.wins, t.draws, t.losses, t.rating, t.rating_deviation
+		FROM teams t
+		INNER JOIN team_members tm ON tm.team_id = t.team_id
+		INNER JOIN users u ON u.user_id = tm.user_id
+		WHERE t.game_id = $1 AND t.wins + t.draws + t.losses > 0
+		GROUP BY t.team_id
+		ORDER BY t.rating DESC, t.wins DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch team leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var leaderboard []models.TeamLeaderboard
+	for rows.Next() {
+		var entry models.TeamLeaderboard
+		if err := rows.Scan(&entry.TeamName, pq.Array(&entry.Members), &entry.Wins, &entry.Draws, &entry.Losses, &entry.Rating, &entry.RatingDeviation); err != nil {
+			return nil, fmt.Errorf("failed to scan team leaderboard row: %w", err)
+		}
+		leaderboard = append(leaderboard, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred while iterating over team leaderboard: %w", err)
+	}
+
+	return leaderboard, nil
+}
+
+// updateTeamStatsTx saves the record and rating of the team within the transaction.
+func updateTeamStatsTx(ctx context.Context, tx *sql.Tx, team *entities.Team) error {
+	query := `
+		UPDATE teams
+		SET wins = $1, losses = $2, draws = $3, rating = $4, rating_deviation = $5, volatility = $6
+		WHERE team_id = $7
+	`
+	_, err := tx.ExecContext(ctx, query, team.Wins, team.Losses, team.Draws, team.Rating, team.RatingDeviation, team.Volatility, team.TeamID)
+	if err != nil {
+		return fmt.Errorf("failed to update team stats: %w", err)
+	}
+	return nil
+}
+
+// DeleteTeam disbands the team. Only its members may do so.
+func (r *teamRepo) DeleteTeam(ctx context.Context, teamID, userID uuid.UUID) error {
+	query := `DELETE FROM teams WHERE team_id = $1 AND EXISTS (SELECT 1 FROM team_members WHERE team_id = $1 AND user_id = $2)`
+	result, err := r.db.ExecContext(ctx, query, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain_errors.ErrNotATeamMember
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/internal/models"
+	"project2/pkg/utils"
+	"strings"
+	"time"
+)
+
+type BlackoutService struct {
+	blackoutRepo        repository_interfaces.BlackoutRepository
+	NotificationService service_interfaces.NotificationService
+}
+
+func NewBlackoutService(blackoutRepo repository_interfaces.BlackoutRepository, notificationService service_interfaces.NotificationService) service_interfaces.BlackoutService {
+	return &BlackoutService{
+		blackoutRepo:        blackoutRepo,
+		NotificationService: notificationService,
+	}
+}
+
+// CreateBlackout closes the game room for the blackout window, cancelling the bookings inside it and
+// notifying the affected players. It returns the number of cancelled bookings.
+func (b *BlackoutService) CreateBlackout(ctx context.Context, blackout *entities.Blackout) (int, error) {
+	if !blackout.EndTime.After(blackout.StartTime) {
+		return 0, errors.New("blackout must end after it starts")
+	}
+	if !blackout.EndTime.After(time.Now()) {
+		return 0, errors.New("blackout has already ended")
+	}
+	blackout.Reason = strings.TrimSpace(blackout.Reason)
+
+	id, cancelled, err := b.blackoutRepo.CreateBlackout(ctx, blackout)
+	if err != nil {
+		return 0, err
+	}
+	blackout.BlackoutID = id
+
+	for _, booking := range cancelled {
+		message := fmt.Sprintf("Your %s booking on %s at %s has been cancelled because the game room is closed.",
+			booking.GameName, utils.FormatDay(booking.StartTime), utils.FormatClock(booking.StartTime))
+		if blackout.Reason != "" {
+			message = fmt.Sprintf("%s Reason: %s.", message, blackout.Reason)
+		}
+		if err := b.NotificationService.NotifyUser(ctx, booking.UserId, message); err != nil {
+			return len(cancelled), fmt.Errorf("blackout created but failed to notify the affected players: %w", err)
+		}
+	}
+
+	return len(cancelled), nil
+}
+
+func (b *BlackoutService) GetUpcomingBlackouts(ctx context.Context) ([]models.Blackouts, error) {
+	return b.blackoutRepo.FetchUpcomingBlackouts(ctx)
+}
+
+func (b *BlackoutService) DeleteBlackout(ctx context.Context, id uuid.UUID) error {
+	return b.blackoutRepo.DeleteBlackout(ctx, id)
+}
@@ -2,73 +2,380 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
 	repository_interfaces "project2/internal/domain/interfaces/repository"
 	service_interfaces "project2/internal/domain/interfaces/service"
 	"project2/internal/models"
+	"project2/pkg/utils"
+	"strings"
 	"time"
 )
 
 type BookingService struct {
-	bookRepo    repository_interfaces.BookingRepository
-	SlotService service_interfaces.SlotService
-	GameService service_interfaces.GameService
+	bookRepo            repository_interfaces.BookingRepository
+	SlotService         service_interfaces.SlotService
+	GameService         service_interfaces.GameService
+	NotificationService service_interfaces.NotificationService
+	UserService         service_interfaces.UserService
+	HolidayService      service_interfaces.HolidayService
 }
 
-func NewBookingService(bookRepo repository_interfaces.BookingRepository, slotService service_interfaces.SlotService, gameService service_interfaces.GameService) service_interfaces.BookingService {
+func NewBookingService(bookRepo repository_interfaces.BookingRepository, slotService service_interfaces.SlotService, gameService service_interfaces.GameService, notificationService service_interfaces.NotificationService, userService service_interfaces.UserService, holidayService service_interfaces.HolidayService) service_interfaces.BookingService {
 	return &BookingService{
-		bookRepo:    bookRepo,
-		SlotService: slotService,
-		GameService: gameService,
+		bookRepo:            bookRepo,
+		SlotService:         slotService,
+		GameService:         gameService,
+		NotificationService: notificationService,
+		UserService:         userService,
+		HolidayService:      holidayService,
 	}
 }
 
+// MakeBooking books the user into the given slot.
+// The capacity check, the insert and the slot status update run in a single transaction,
+// so two users racing for the last seat can never push the slot over MaxPlayers.
+// Bookings overlapping another booking of the user, in any game, are rejected with a *domain_errors.BookingOverlapError.
 func (b *BookingService) MakeBooking(ctx context.Context, userID, slotID uuid.UUID) error {
+	_, game, err := b.getBookableSlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+
+	// Create the booking atomically
+	newBooking := &entities.Booking{SlotID: slotID, UserID: userID}
+	if _, err := b.bookRepo.CreateBookingWithinCapacity(ctx, newBooking, game.MaxPlayers); err != nil {
+		var overlap *domain_errors.BookingOverlapError
+		if errors.Is(err, domain_errors.ErrSlotFull) || errors.Is(err, domain_errors.ErrAlreadyBooked) || errors.As(err, &overlap) {
+			return err
+		}
+		return fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	return nil
+}
+
+// MakeGroupBooking books the organiser and every member, given by email or username, into the slot.
+// All the bookings are created in one transaction, so either the whole party gets a seat or nobody does.
+// Each member is notified that the organiser booked them.
+func (b *BookingService) MakeGroupBooking(ctx context.Context, organiserID, slotID uuid.UUID, members []string) error {
+	slot, game, err := b.getBookableSlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+
+	organiser, err := b.UserService.GetUserByID(ctx, organiserID)
+	if err != nil {
+		return fmt.Errorf("failed to get organiser details: %w", err)
+	}
+
+	// Resolve the members, keeping the organiser first and dropping duplicates
+	userIDs := []uuid.UUID{organiserID}
+	identifiers := map[uuid.UUID]string{organiserID: "you"}
+	for _, member := range members {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+
+		user, err := b.findUser(ctx, member)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := identifiers[user.UserID]; ok {
+			continue
+		}
+		identifiers[user.UserID] = member
+		userIDs = append(userIDs, user.UserID)
+	}
+
+	if len(userIDs) > game.MaxPlayers {
+		return fmt.Errorf("a party of %d is more than the %d players allowed in %s", len(userIDs), game.MaxPlayers, game.GameName)
+	}
+
+	if _, err := b.bookRepo.CreateGroupBooking(ctx, slotID, userIDs, game.MaxPlayers); err != nil {
+		var memberErr *domain_errors.GroupMemberError
+		if !errors.As(err, &memberErr) {
+			return fmt.Errorf("failed to create group booking: %w", err)
+		}
+
+		var overlap *domain_errors.BookingOverlapError
+		switch {
+		case errors.Is(err, domain_errors.ErrSlotFull):
+			return fmt.Errorf("not enough free seats for a party of %d: %w", len(userIDs), domain_errors.ErrSlotFull)
+		case errors.Is(err, domain_errors.ErrAlreadyBooked), errors.As(err, &overlap):
+			return fmt.Errorf("cannot book %s: %w", identifiers[memberErr.UserID], memberErr.Err)
+		default:
+			return fmt.Errorf("failed to create group booking: %w", memberErr.Err)
+		}
+	}
+
+	// Let every member know who booked them
+	message := fmt.Sprintf("%s booked you into the %s slot on %s at %s.", organiser.Username, game.GameName,
+		utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime))
+	for _, userID := range userIDs[1:] {
+		if err := b.NotificationService.NotifyUser(ctx, userID, message); err != nil {
+			return fmt.Errorf("party booked but failed to notify the members: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findUser looks a user up by email, or by username if the identifier is not an email address
+func (b *BookingService) findUser(ctx context.Context, identifier string) (*entities.User, error) {
+	var user *entities.User
+	var err error
+	if strings.Contains(identifier, "@") {
+		user, err = b.UserService.GetUserByEmail(ctx, identifier)
+	} else {
+		user, err = b.UserService.GetUserByUsername(ctx, identifier)
+	}
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("cannot find user %q", identifier)
+	}
+	return user, nil
+}
+
+// getBookableSlot fetches the slot and its game, checking that the slot can still be booked.
+func (b *BookingService) getBookableSlot(ctx context.Context, slotID uuid.UUID) (*entities.Slot, *entities.Game, error) {
 	// Fetch the slot and validate
 	slot, err := b.SlotService.GetSlotByID(ctx, slotID)
 	if err != nil {
-		return fmt.Errorf("failed to get slot details: %w", err)
+		return nil, nil, fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return nil, nil, errors.New("slot not found")
 	}
 	if slot.IsBooked {
-		return fmt.Errorf("slot is already booked")
+		return nil, nil, domain_errors.ErrSlotFull
 	}
 	if slot.StartTime.Before(time.Now()) {
-		return fmt.Errorf("slot has already passed")
+		return nil, nil, fmt.Errorf("slot has already passed")
 	}
 
-	// Check if the user is already booked
-	if booking, _ := b.bookRepo.FetchBookingBySlotAndUserId(ctx, slotID, userID); booking.BookingId != uuid.Nil {
-		return fmt.Errorf("user is already booked in this slot")
+	// Slots generated before a holiday was added are kept for their bookings, but cannot be booked
+	_, closed, err := b.HolidayService.GetClosure(ctx, slot.StartTime.In(config.Location()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check the holidays: %w", err)
+	}
+	if closed {
+		return nil, nil, domain_errors.ErrGameRoomClosed
 	}
 
-	// Create new booking
-	newBooking := &entities.Booking{SlotID: slotID, UserID: userID}
-	if _, err := b.bookRepo.CreateBooking(ctx, newBooking); err != nil {
-		return fmt.Errorf("failed to create booking: %w", err)
+	// Fetch the game to know the capacity of the slot
+	game, err := b.GameService.GetGameByID(ctx, slot.GameID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get game details: %w", err)
+	}
+	if game == nil {
+		return nil, nil, errors.New("game not found")
+	}
+
+	return slot, game, nil
+}
+
+// CancelBooking removes the user's booking and hands the freed seat to the first user on the
+// slot's waitlist, who is notified of the promotion. Bookings can only be cancelled before the slot starts.
+func (b *BookingService) CancelBooking(ctx context.Context, userID, bookingID uuid.UUID) error {
+	booking, err := b.bookRepo.FetchBookingByID(ctx, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to get booking details: %w", err)
+	}
+	if booking == nil || booking.UserID != userID {
+		return errors.New("booking not found")
+	}
+
+	slot, err := b.SlotService.GetSlotByID(ctx, booking.SlotID)
+	if err != nil {
+		return fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return errors.New("slot not found")
+	}
+	if !slot.StartTime.After(time.Now()) {
+		return errors.New("cannot cancel a booking for a slot that has already started")
 	}
 
-	// Fetch game and current bookings
 	game, err := b.GameService.GetGameByID(ctx, slot.GameID)
 	if err != nil {
 		return fmt.Errorf("failed to get game details: %w", err)
 	}
-	bookings, err := b.bookRepo.FetchBookingsBySlotID(ctx, slotID)
+	if game == nil {
+		return errors.New("game not found")
+	}
+
+	promotedUserID, err := b.bookRepo.CancelBookingAndPromote(ctx, bookingID, game.MaxPlayers)
+	if err != nil {
+		return fmt.Errorf("failed to cancel booking: %w", err)
+	}
+
+	if promotedUserID != uuid.Nil {
+		message := fmt.Sprintf("A seat opened up in the %s slot on %s at %s and you have been booked into it from the waitlist.",
+			game.GameName, utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime))
+		if err := b.NotificationService.NotifyUser(ctx, promotedUserID, message); err != nil {
+			return fmt.Errorf("booking cancelled but failed to notify the next player on the waitlist: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RescheduleBooking moves the user's booking to another slot of the same game. The new seat is acquired and the
+// old one released in a single transaction, so the user never ends up with both seats or with neither.
+// The first user on the old slot's waitlist is booked into the freed seat and notified.
+func (b *BookingService) RescheduleBooking(ctx context.Context, userID, bookingID, newSlotID uuid.UUID) error {
+	booking, err := b.bookRepo.FetchBookingByID(ctx, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to get booking details: %w", err)
+	}
+	if booking == nil || booking.UserID != userID {
+		return errors.New("booking not found")
+	}
+	if booking.SlotID == newSlotID {
+		return errors.New("the booking is already in this slot")
+	}
+
+	oldSlot, err := b.SlotService.GetSlotByID(ctx, booking.SlotID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch bookings: %w", err)
+		return fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if oldSlot == nil {
+		return errors.New("slot not found")
+	}
+	if !oldSlot.StartTime.After(time.Now()) {
+		return errors.New("cannot reschedule a booking for a slot that has already started")
 	}
 
-	// Mark slot as booked if the max players are reached
-	if len(bookings) == game.MaxPlayers {
-		if err := b.SlotService.MarkSlotAsBooked(ctx, slotID); err != nil {
-			return fmt.Errorf("failed to update slot status: %w", err)
+	_, game, err := b.getBookableSlot(ctx, newSlotID)
+	if err != nil {
+		return err
+	}
+	if game.GameID != oldSlot.GameID {
+		return fmt.Errorf("a booking can only be moved to another %s slot", game.GameName)
+	}
+
+	_, promotedUserID, err := b.bookRepo.RescheduleBooking(ctx, bookingID, newSlotID, game.MaxPlayers)
+	if err != nil {
+		var overlap *domain_errors.BookingOverlapError
+		if errors.Is(err, domain_errors.ErrSlotFull) || errors.Is(err, domain_errors.ErrAlreadyBooked) || errors.As(err, &overlap) {
+			return err
+		}
+		return fmt.Errorf("failed to reschedule booking: %w", err)
+	}
+
+	if promotedUserID != uuid.Nil {
+		message := fmt.Sprintf("A seat opened up in the %s slot on %s at %s and you have been booked into it from the waitlist.",
+			game.GameName, utils.FormatDay(oldSlot.StartTime), utils.FormatClock(oldSlot.StartTime))
+		if err := b.NotificationService.NotifyUser(ctx, promotedUserID, message); err != nil {
+			return fmt.Errorf("booking rescheduled but failed to notify the next player on the waitlist: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// TransferBooking hands the user's booking over to a colleague, given by email or username.
+// The recipient must not already be in the slot nor have an overlapping booking. Both users are notified.
+func (b *BookingService) TransferBooking(ctx context.Context, userID, bookingID uuid.UUID, recipient string) error {
+	booking, err := b.bookRepo.FetchBookingByID(ctx, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to get booking details: %w", err)
+	}
+	if booking == nil || booking.UserID != userID {
+		return errors.New("booking not found")
+	}
+
+	toUser, err := b.findUser(ctx, strings.TrimSpace(recipient))
+	if err != nil {
+		return err
+	}
+	if toUser.UserID == userID {
+		return errors.New("cannot transfer a booking to yourself")
+	}
+
+	slot, err := b.SlotService.GetSlotByID(ctx, booking.SlotID)
+	if err != nil {
+		return fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return errors.New("slot not found")
+	}
+	if !slot.StartTime.After(time.Now()) {
+		return errors.New("cannot transfer a booking for a slot that has already started")
+	}
+
+	game, err := b.GameService.GetGameByID(ctx, slot.GameID)
+	if err != nil {
+		return fmt.Errorf("failed to get game details: %w", err)
+	}
+	if game == nil {
+		return errors.New("game not found")
+	}
+
+	fromUser, err := b.UserService.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user details: %w", err)
+	}
+
+	if err := b.bookRepo.TransferBooking(ctx, bookingID, toUser.UserID); err != nil {
+		return fmt.Errorf("cannot transfer booking to %s: %w", toUser.Username, err)
+	}
+
+	when := fmt.Sprintf("%s slot on %s at %s", game.GameName, utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime))
+	if err := b.NotificationService.NotifyUser(ctx, toUser.UserID, fmt.Sprintf("%s handed you their seat in the %s.", fromUser.Username, when)); err != nil {
+		return fmt.Errorf("booking transferred but failed to notify %s: %w", toUser.Username, err)
+	}
+	if err := b.NotificationService.NotifyUser(ctx, userID, fmt.Sprintf("Your seat in the %s now belongs to %s.", when, toUser.Username)); err != nil {
+		return fmt.Errorf("booking transferred but failed to send you a confirmation: %w", err)
+	}
+
+	return nil
+}
+
+// JoinWaitlist queues the user for a full slot and returns their position in the queue.
+func (b *BookingService) JoinWaitlist(ctx context.Context, userID, slotID uuid.UUID) (int, error) {
+	slot, err := b.SlotService.GetSlotByID(ctx, slotID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return 0, errors.New("slot not found")
+	}
+	if slot.StartTime.Before(time.Now()) {
+		return 0, fmt.Errorf("slot has already passed")
+	}
+
+	if err := b.bookRepo.AddToWaitlist(ctx, &entities.WaitlistEntry{SlotID: slotID, UserID: userID}); err != nil {
+		if errors.Is(err, domain_errors.ErrSlotNotFull) || errors.Is(err, domain_errors.ErrAlreadyBooked) || errors.Is(err, domain_errors.ErrAlreadyWaitlisted) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("failed to join waitlist: %w", err)
+	}
+
+	position, _, err := b.bookRepo.FetchWaitlistPosition(ctx, slotID, userID)
+	if err != nil {
+		return 0, err
+	}
+	return position, nil
+}
+
+// LeaveWaitlist takes the user out of the waitlist of a slot.
+func (b *BookingService) LeaveWaitlist(ctx context.Context, userID, slotID uuid.UUID) error {
+	return b.bookRepo.RemoveFromWaitlist(ctx, slotID, userID)
+}
+
+// GetWaitlistPosition returns the user's position in the slot's waitlist (0 if not waiting)
+// and the number of users waiting.
+func (b *BookingService) GetWaitlistPosition(ctx context.Context, userID, slotID uuid.UUID) (int, int, error) {
+	return b.bookRepo.FetchWaitlistPosition(ctx, slotID, userID)
+}
+
 // GetUpcomingBookings retrieves all upcoming bookings for a given user.
 func (b *BookingService) GetUpcomingBookings(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error) {
 	return b.bookRepo.FetchUpcomingBookingsByUserID(ctx, userID)
@@ -86,6 +393,30 @@ func (b *BookingService) GetSlotBookedUsers(ctx context.Context, slotId uuid.UUI
 	return b.bookRepo.FetchSlotBookedUsers(ctx, slotId)
 }
 
+// GetSlotBookingCounts returns the number of players booked in each of the given slots.
+func (b *BookingService) GetSlotBookingCounts(ctx context.Context, slotIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	return b.bookRepo.FetchBookingCountsBySlotIDs(ctx, slotIDs)
+}
+
 func (b *BookingService) GetBookingByUserAndSlotID(ctx context.Context, userID uuid.UUID, slotID uuid.UUID) (models.Bookings, error) {
 	return b.bookRepo.FetchBookingBySlotAndUserId(ctx, slotID, userID)
 }
+
+// CheckIn records that the user turned up for the booking with the given code.
+// Check-in is open from CheckInWindowMinutes before the slot starts until CheckInWindowMinutes after.
+func (b *BookingService) CheckIn(ctx context.Context, userID uuid.UUID, code string) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return domain_errors.ErrInvalidCheckInCode
+	}
+
+	now := time.Now()
+	window := time.Duration(config.CheckInWindowMinutes) * time.Minute
+	_, err := b.bookRepo.CheckInBooking(ctx, userID, code, now.Add(-window), now.Add(window))
+	return err
+}
+
+// GetAttendanceStats returns how many times the user checked in to or missed their bookings.
+func (b *BookingService) GetAttendanceStats(ctx context.Context, userID uuid.UUID) (models.AttendanceStats, error) {
+	return b.bookRepo.FetchAttendanceStats(ctx, userID)
+}
@@ -5,10 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
 	repository_interfaces "project2/internal/domain/interfaces/repository"
 	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/pkg/scoring"
+	"project2/pkg/validation"
 	"sync"
+	"time"
 )
 
 type GameService struct {
@@ -42,7 +46,27 @@ func (s *GameService) GetAllGames(ctx context.Context) ([]entities.Game, error)
 }
 
 // CreateGame creates a new game
+// Games created without operating hours or scoring strategy get the defaults.
 func (s *GameService) CreateGame(ctx context.Context, game *entities.Game) (uuid.UUID, error) {
+	if game.OpenTime == "" {
+		game.OpenTime = config.DefaultOpenTime
+	}
+	if game.CloseTime == "" {
+		game.CloseTime = config.DefaultCloseTime
+	}
+	if game.SlotDuration == 0 {
+		game.SlotDuration = config.DefaultSlotDuration
+	}
+	if game.ScoringStrategy == "" {
+		game.ScoringStrategy = config.DefaultScoringStrategy
+	}
+	if err := validateGameSchedules(game); err != nil {
+		return uuid.Nil, err
+	}
+	if !scoring.IsValid(game.ScoringStrategy) {
+		return uuid.Nil, fmt.Errorf("unknown scoring strategy %q", game.ScoringStrategy)
+	}
+
 	id, err := s.gameRepo.CreateGame(ctx, game)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to create game: %w", err)
@@ -75,3 +99,64 @@ func (s *GameService) UpdateGameStatus(ctx context.Context, id uuid.UUID, status
 	}
 	return nil
 }
+
+// UpdateGameSchedule updates the operating hours, slot duration and weekday overrides of a game
+func (s *GameService) UpdateGameSchedule(ctx context.Context, game *entities.Game) error {
+	if err := validateGameSchedules(game); err != nil {
+		return err
+	}
+
+	err := s.gameRepo.UpdateGameSchedule(ctx, game)
+	if err != nil {
+		return fmt.Errorf("failed to update game schedule: %w", err)
+	}
+	return nil
+}
+
+// UpdateScoringStrategy changes how the leaderboard of a game is scored. The scores themselves are recomputed by
+// the leaderboard service.
+func (s *GameService) UpdateScoringStrategy(ctx context.Context, id uuid.UUID, strategy string) error {
+	if !scoring.IsValid(strategy) {
+		return fmt.Errorf("unknown scoring strategy %q", strategy)
+	}
+
+	err := s.gameRepo.UpdateScoringStrategy(ctx, id, strategy)
+	if err != nil {
+		return fmt.Errorf("failed to update scoring strategy: %w", err)
+	}
+	return nil
+}
+
+// validateGameSchedules checks the default schedule of the game and all of its weekday overrides
+func validateGameSchedules(game *entities.Game) error {
+	defaultSchedule := entities.GameSchedule{OpenTime: game.OpenTime, CloseTime: game.CloseTime, SlotDuration: game.SlotDuration}
+	if err := validateGameSchedule(defaultSchedule); err != nil {
+		return err
+	}
+	for weekday, schedule := range game.WeekdaySchedules {
+		if err := validateGameSchedule(schedule); err != nil {
+			return fmt.Errorf("invalid schedule for %s: %w", weekday, err)
+		}
+	}
+	return nil
+}
+
+// validateGameSchedule checks that the game opens before it closes and that at least one slot fits in between
+func validateGameSchedule(schedule entities.GameSchedule) error {
+	if !validation.IsValidTimeOfDay(schedule.OpenTime) || !validation.IsValidTimeOfDay(schedule.CloseTime) {
+		return errors.New("opening and closing times must be in HH:MM format")
+	}
+	if schedule.OpenTime >= schedule.CloseTime {
+		return errors.New("opening time must be before closing time")
+	}
+	if schedule.SlotDuration <= 0 {
+		return errors.New("slot duration must be a positive number of minutes")
+	}
+
+	openTime, _ := time.Parse("15:04", schedule.OpenTime)
+	closeTime, _ := time.Parse("15:04", schedule.CloseTime)
+	if time.Duration(schedule.SlotDuration)*time.Minute > closeTime.Sub(openTime) {
+		return errors.New("slot duration is longer than the opening hours")
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"project2/internal/domain/entities"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/internal/models"
+	"project2/pkg/utils"
+	"strings"
+	"time"
+)
+
+type HolidayService struct {
+	holidayRepo         repository_interfaces.HolidayRepository
+	NotificationService service_interfaces.NotificationService
+}
+
+func NewHolidayService(holidayRepo repository_interfaces.HolidayRepository, notificationService service_interfaces.NotificationService) service_interfaces.HolidayService {
+	return &HolidayService{
+		holidayRepo:         holidayRepo,
+		NotificationService: notificationService,
+	}
+}
+
+// ImportHolidays reads a CSV, YAML or ICS calendar file and stores its holidays. It returns the number of holidays imported.
+func (h *HolidayService) ImportHolidays(ctx context.Context, path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open calendar: %w", err)
+	}
+	defer file.Close()
+
+	holidays, err := utils.ParseHolidayCalendar(file, filepath.Ext(path))
+	if err != nil {
+		return 0, err
+	}
+	if len(holidays) == 0 {
+		return 0, errors.New("the calendar does not contain any holidays")
+	}
+
+	booked, err := h.holidayRepo.CreateHolidays(ctx, holidays)
+	if err != nil {
+		return 0, err
+	}
+	if err := h.notifyBookedPlayers(ctx, booked); err != nil {
+		return len(holidays), err
+	}
+	return len(holidays), nil
+}
+
+func (h *HolidayService) AddHoliday(ctx context.Context, holiday *entities.Holiday) error {
+	holiday.Name = strings.TrimSpace(holiday.Name)
+	booked, err := h.holidayRepo.CreateHolidays(ctx, []entities.Holiday{*holiday})
+	if err != nil {
+		return err
+	}
+	return h.notifyBookedPlayers(ctx, booked)
+}
+
+// notifyBookedPlayers tells the players who booked a slot on a new holiday that the game room is closed that day
+func (h *HolidayService) notifyBookedPlayers(ctx context.Context, booked []models.HolidayBooking) error {
+	for _, booking := range booked {
+		closure := fmt.Sprintf("The game room is closed on %s", utils.FormatDay(booking.StartTime))
+		if booking.HolidayName != "" {
+			closure = fmt.Sprintf("%s for %s", closure, booking.HolidayName)
+		}
+		message := fmt.Sprintf("%s. Please cancel or reschedule your %s booking at %s.", closure, booking.GameName, utils.FormatClock(booking.StartTime))
+		if err := h.NotificationService.NotifyUser(ctx, booking.UserId, message); err != nil {
+			return fmt.Errorf("holiday added but failed to notify the booked players: %w", err)
+		}
+	}
+	return nil
+}
+
+func (h *HolidayService) GetUpcomingHolidays(ctx context.Context) ([]entities.Holiday, error) {
+	return h.holidayRepo.FetchUpcomingHolidays(ctx)
+}
+
+func (h *HolidayService) DeleteHoliday(ctx context.Context, date time.Time) error {
+	return h.holidayRepo.DeleteHoliday(ctx, date)
+}
+
+// GetClosure reports whether the game room is closed on the given date and, if so, why.
+func (h *HolidayService) GetClosure(ctx context.Context, date time.Time) (string, bool, error) {
+	if !utils.IsWorkingDay(date.Weekday()) {
+		return fmt.Sprintf("The game room is closed on %ss", date.Weekday()), true, nil
+	}
+
+	holidays, err := h.holidayRepo.FetchHolidaysBetween(ctx, date, date)
+	if err != nil {
+		return "", false, err
+	}
+	if len(holidays) == 0 {
+		return "", false, nil
+	}
+	if holidays[0].Name == "" {
+		return "The game room is closed for a holiday", true, nil
+	}
+	return fmt.Sprintf("The game room is closed for %s", holidays[0].Name), true, nil
+}
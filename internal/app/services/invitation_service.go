@@ -10,8 +10,8 @@ import (
 	service_interfaces "project2/internal/domain/interfaces/service"
 	"project2/internal/models"
 	"project2/pkg/globals"
+	"project2/pkg/utils"
 	"sync"
-	"time"
 )
 
 type InvitationService struct {
@@ -58,9 +58,7 @@ func (s *InvitationService) MakeInvitation(ctx context.Context, invitingUserID,
 	}
 
 	// Check if the slot time has already passed
-	location, _ := time.LoadLocation("Asia/Kolkata")
-	currentTime := time.Now().In(location)
-	if slot.EndTime.Before(currentTime) {
+	if slot.EndTime.Before(utils.Now()) {
 		return uuid.Nil, errors.New("cannot invite to a slot that has already passed")
 	}
 
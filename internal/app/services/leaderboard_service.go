@@ -4,91 +4,201 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
 	repository_interfaces "project2/internal/domain/interfaces/repository"
 	service_interfaces "project2/internal/domain/interfaces/service"
 	"project2/internal/models"
-	"project2/pkg/utils"
+	"project2/pkg/rating"
+	"project2/pkg/scoring"
 	"sync"
 )
 
 type LeaderboardService struct {
 	leaderBoardRepo repository_interfaces.LeaderboardRepository
-	bookingService  service_interfaces.BookingService
+	gameService     service_interfaces.GameService
 	leaderboardWG   *sync.WaitGroup
 }
 
-func NewLeaderboardService(leaderBoardRepo repository_interfaces.LeaderboardRepository, bookingService service_interfaces.BookingService) service_interfaces.LeaderboardService {
+func NewLeaderboardService(leaderBoardRepo repository_interfaces.LeaderboardRepository, gameService service_interfaces.GameService) service_interfaces.LeaderboardService {
 	return &LeaderboardService{
 		leaderBoardRepo: leaderBoardRepo,
-		bookingService:  bookingService,
+		gameService:     gameService,
 		leaderboardWG:   &sync.WaitGroup{},
 	}
 }
 
 func (s *LeaderboardService) GetGameLeaderboard(ctx context.Context, gameId uuid.UUID) ([]models.Leaderboard, error) {
-	return s.leaderBoardRepo.FetchGameLeaderboard(ctx, gameId)
+	return s.leaderBoardRepo.FetchGameLeaderboard(ctx, gameId, config.ProvisionalGames)
 }
 
-func (s *LeaderboardService) AddWinToUser(ctx context.Context, userId uuid.UUID, gameId uuid.UUID, bookingId uuid.UUID) error {
-	userStats, err := s.leaderBoardRepo.FetchUserGameStats(ctx, userId, gameId)
+// ScoreMatch counts the outcome of every player of a confirmed match in the standings of the game, which hold the
+// current stats of all its players, updates their rating and rescores the leaderboard with the strategy of the game.
+// It returns the leaderboard entries that changed, players first, without saving them, so that they can be saved
+// together with the match.
+// All ratings are computed from the ratings before the match, each player being rated against the players of the
+// other sides they won against, lost to or drew with. Players without such an opponent keep their rating.
+func (s *LeaderboardService) ScoreMatch(ctx context.Context, gameId uuid.UUID, standings []*entities.Leaderboard, players []entities.MatchPlayer) ([]*entities.Leaderboard, error) {
+	system, err := rating.New(config.RatingSystem, config.EloKFactor, config.GlickoTau)
 	if err != nil {
-		return fmt.Errorf("failed to fetch user stats for game %s: %w", gameId, err)
+		return nil, err
+	}
+	strategy, err := s.scoringStrategy(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	scoresBefore := make(map[uuid.UUID]float64, len(standings))
+	for _, standing := range standings {
+		scoresBefore[standing.ScoreID] = standing.Score
 	}
 
-	if userStats == nil {
-		userStats = &entities.Leaderboard{
-			ScoreID: uuid.New(),
-			UserID:  userId,
-			GameID:  gameId,
-			Wins:    0,
-			Losses:  0,
-			Score:   0,
+	stats := make([]*entities.Leaderboard, len(players))
+	before := make([]rating.Rating, len(players))
+	for i, player := range players {
+		stats[i] = findStanding(standings, player.UserID)
+		if stats[i] == nil {
+			stats[i] = newUserStats(player.UserID, gameId)
+			standings = append(standings, stats[i])
 		}
+		before[i] = rating.Rating{Value: stats[i].Rating, Deviation: stats[i].RatingDeviation, Volatility: stats[i].Volatility}
 	}
 
-	userStats.Wins++
-	newUserScore := utils.GetTotalScore(userStats.Wins, userStats.Losses)
-	userStats.Score = float64(newUserScore)
-	err = s.leaderBoardRepo.UpdateUserGameStats(ctx, userStats)
+	for i, player := range players {
+		var results []rating.Result
+		for j, opponent := range players {
+			if opponent.Side == player.Side {
+				continue
+			}
+			if score, ok := pairingScore(player.Outcome, opponent.Outcome); ok {
+				results = append(results, rating.Result{Opponent: before[j], Score: score})
+			}
+		}
+		if len(results) > 0 {
+			after := system.Update(before[i], results)
+			stats[i].Rating, stats[i].RatingDeviation, stats[i].Volatility = after.Value, after.Deviation, after.Volatility
+		}
+
+		if err := countOutcome(stats[i], player.Outcome); err != nil {
+			return nil, err
+		}
+	}
+	strategy.Update(standings, players)
+
+	// Strategies that rank players against each other can also move players who did not play
+	for _, standing := range standings {
+		if previous, ok := scoresBefore[standing.ScoreID]; ok && previous != standing.Score && findPlayer(players, standing.UserID) == nil {
+			stats = append(stats, standing)
+		}
+	}
+	return stats, nil
+}
+
+// ChangeScoringStrategy switches the game to another scoring strategy and recomputes the scores of its leaderboard.
+// Choosing the current strategy again only recomputes the scores.
+func (s *LeaderboardService) ChangeScoringStrategy(ctx context.Context, gameId uuid.UUID, strategy string) error {
+	if err := s.gameService.UpdateScoringStrategy(ctx, gameId, strategy); err != nil {
+		return err
+	}
+	return s.recomputeScores(ctx, gameId)
+}
+
+// recomputeScores rescores the whole leaderboard of the game from the stats of its players and its match history
+func (s *LeaderboardService) recomputeScores(ctx context.Context, gameId uuid.UUID) error {
+	strategy, err := s.scoringStrategy(ctx, gameId)
 	if err != nil {
-		return fmt.Errorf("failed to update user stats for game %s: %w", gameId, err)
+		return err
 	}
-	err = s.bookingService.UpdateBookingResult(ctx, bookingId, "win")
+
+	standings, err := s.leaderBoardRepo.FetchGameStats(ctx, gameId)
 	if err != nil {
-		return fmt.Errorf("failed to update booking result for game %s: %w", gameId, err)
+		return fmt.Errorf("failed to fetch stats for game %s: %w", gameId, err)
+	}
+	if len(standings) == 0 {
+		return nil
+	}
+	history, err := s.leaderBoardRepo.FetchMatchHistory(ctx, gameId)
+	if err != nil {
+		return fmt.Errorf("failed to fetch match history for game %s: %w", gameId, err)
 	}
-	return nil
 
+	strategy.Recompute(standings, history)
+	if err := s.leaderBoardRepo.UpdateScores(ctx, standings); err != nil {
+		return fmt.Errorf("failed to update scores for game %s: %w", gameId, err)
+	}
+	return nil
 }
 
-func (s *LeaderboardService) AddLossToUser(ctx context.Context, userId uuid.UUID, gameId uuid.UUID, bookingId uuid.UUID) error {
-	userStats, err := s.leaderBoardRepo.FetchUserGameStats(ctx, userId, gameId)
+// scoringStrategy resolves the strategy the leaderboard of the game is scored with
+func (s *LeaderboardService) scoringStrategy(ctx context.Context, gameId uuid.UUID) (scoring.Strategy, error) {
+	game, err := s.gameService.GetGameByID(ctx, gameId)
 	if err != nil {
-		return fmt.Errorf("failed to fetch user stats for game %s: %w", gameId, err)
+		return nil, err
 	}
+	if game == nil {
+		return nil, fmt.Errorf("game %s not found", gameId)
+	}
+	return scoring.New(game.ScoringStrategy, scoring.Points{Win: config.PointsPerWin, Draw: config.PointsPerDraw, Loss: config.PointsPerLoss})
+}
 
-	if userStats == nil {
-		userStats = &entities.Leaderboard{
-			ScoreID: uuid.New(),
-			UserID:  userId,
-			GameID:  gameId,
-			Wins:    0,
-			Losses:  0,
-			Score:   0,
-		}
+// pairingScore scores a player's outcome against one opponent. Two losers of a match with more than two sides
+// did not play each other to a result, so their pairing is not rated.
+func pairingScore(outcome, opponentOutcome string) (float64, bool) {
+	switch {
+	case outcome == entities.OutcomeDraw:
+		return 0.5, true
+	case outcome == entities.OutcomeWin:
+		return 1, true
+	case opponentOutcome == entities.OutcomeWin:
+		return 0, true
+	default:
+		return 0, false
 	}
+}
 
-	userStats.Losses++
-	newUserScore := utils.GetTotalScore(userStats.Wins, userStats.Losses)
-	userStats.Score = float64(newUserScore)
-	err = s.leaderBoardRepo.UpdateUserGameStats(ctx, userStats)
-	if err != nil {
-		return fmt.Errorf("failed to update user stats for game %s: %w", gameId, err)
+func countOutcome(userStats *entities.Leaderboard, outcome string) error {
+	switch outcome {
+	case entities.OutcomeWin:
+		userStats.Wins++
+	case entities.OutcomeLoss:
+		userStats.Losses++
+	case entities.OutcomeDraw:
+		userStats.Draws++
+	default:
+		return fmt.Errorf("invalid outcome %q", outcome)
 	}
-	err = s.bookingService.UpdateBookingResult(ctx, bookingId, "loss")
-	if err != nil {
-		return fmt.Errorf("failed to update booking result for game %s: %w", gameId, err)
+	return nil
+}
+
+func findStanding(standings []*entities.Leaderboard, userId uuid.UUID) *entities.Leaderboard {
+	for _, standing := range standings {
+		if standing.UserID == userId {
+			return standing
+		}
+	}
+	return nil
+}
+
+func findPlayer(players []entities.MatchPlayer, userId uuid.UUID) *entities.MatchPlayer {
+	for i := range players {
+		if players[i].UserID == userId {
+			return &players[i]
+		}
 	}
 	return nil
 }
+
+// newUserStats returns the stats of a user who has not played the game yet
+func newUserStats(userId uuid.UUID, gameId uuid.UUID) *entities.Leaderboard {
+	return &entities.Leaderboard{
+		ScoreID:         uuid.New(),
+		UserID:          userId,
+		GameID:          gameId,
+		Wins:            0,
+		Losses:          0,
+		Score:           0,
+		Rating:          config.InitialRating,
+		RatingDeviation: config.InitialRatingDeviation,
+		Volatility:      config.InitialVolatility,
+	}
+}
@@ -24,3 +24,9 @@ func NewNotificationService(notificationRepo repository_interfaces.NotificationR
 func (n *NotificationService) GetUserNotifications(ctx context.Context, userId uuid.UUID) ([]entities.Notification, error) {
 	return n.notificationRepo.FetchUserNotifications(ctx, userId)
 }
+
+// NotifyUser sends a notification with the given message to the user.
+func (n *NotificationService) NotifyUser(ctx context.Context, userId uuid.UUID, message string) error {
+	_, err := n.notificationRepo.CreateNotification(ctx, &entities.Notification{UserID: userId, Message: message})
+	return err
+}
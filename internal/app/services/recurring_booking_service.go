@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/internal/models"
+	"project2/pkg/utils"
+	"project2/pkg/validation"
+	"time"
+)
+
+type RecurringBookingService struct {
+	recurringRepo       repository_interfaces.RecurringBookingRepository
+	BookingService      service_interfaces.BookingService
+	SlotService         service_interfaces.SlotService
+	GameService         service_interfaces.GameService
+	NotificationService service_interfaces.NotificationService
+}
+
+func NewRecurringBookingService(recurringRepo repository_interfaces.RecurringBookingRepository, bookingService service_interfaces.BookingService, slotService service_interfaces.SlotService, gameService service_interfaces.GameService, notificationService service_interfaces.NotificationService) service_interfaces.RecurringBookingService {
+	return &RecurringBookingService{
+		recurringRepo:       recurringRepo,
+		BookingService:      bookingService,
+		SlotService:         slotService,
+		GameService:         gameService,
+		NotificationService: notificationService,
+	}
+}
+
+// CreateRecurringBooking stores the recurrence and immediately books its occurrences on the days whose
+// slots have already been generated. Occurrences that could not be booked are returned as conflicts.
+func (r *RecurringBookingService) CreateRecurringBooking(ctx context.Context, recurrence *entities.RecurringBooking) ([]models.BookingConflict, error) {
+	if len(recurrence.Weekdays) == 0 {
+		return nil, errors.New("at least one weekday must be selected")
+	}
+	if !validation.IsValidTimeOfDay(recurrence.StartTime) {
+		return nil, errors.New("start time must be in HH:MM format")
+	}
+	if recurrence.EndDate.Format("2006-01-02") < utils.Now().Format("2006-01-02") {
+		return nil, errors.New("end date cannot be in the past")
+	}
+
+	game, err := r.GameService.GetGameByID(ctx, recurrence.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game details: %w", err)
+	}
+	if game == nil {
+		return nil, errors.New("game not found")
+	}
+
+	id, err := r.recurringRepo.CreateRecurringBooking(ctx, recurrence)
+	if err != nil {
+		return nil, err
+	}
+	recurrence.RecurrenceID = id
+
+	location := config.Location()
+
+	// Days without slots yet are booked when their slots are generated
+	var conflicts []models.BookingConflict
+	today := utils.StartOfDay(utils.Now())
+	for day := 0; day < config.BookingHorizonDays; day++ {
+		date := today.AddDate(0, 0, day)
+		if !occursOn(recurrence, date) {
+			continue
+		}
+
+		slots, err := r.SlotService.GetGameSlotsByDate(ctx, game.GameID, date)
+		if err != nil {
+			return conflicts, fmt.Errorf("failed to fetch slots: %w", err)
+		}
+		if len(slots) == 0 {
+			continue
+		}
+
+		conflict, err := r.bookOccurrence(ctx, recurrence, game, date, slots, location)
+		if err != nil {
+			return conflicts, err
+		}
+		if conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+
+	return conflicts, nil
+}
+
+// GetUserRecurringBookings retrieves all recurring bookings of the user.
+func (r *RecurringBookingService) GetUserRecurringBookings(ctx context.Context, userID uuid.UUID) ([]models.RecurringBookings, error) {
+	return r.recurringRepo.FetchRecurringBookingsByUserID(ctx, userID)
+}
+
+// DeleteRecurringBooking stops a recurrence. Occurrences that have already been booked are kept.
+func (r *RecurringBookingService) DeleteRecurringBooking(ctx context.Context, userID uuid.UUID, recurrenceID uuid.UUID) error {
+	return r.recurringRepo.DeleteRecurringBooking(ctx, recurrenceID, userID)
+}
+
+// MaterialiseRecurringBookings books the active recurrences into the days of the newly generated slots.
+// Every slot of such a day is considered, not only the new ones, since the tables of a day can be generated
+// in several runs. Users whose occurrence could not be booked are notified of the conflict.
+func (r *RecurringBookingService) MaterialiseRecurringBookings(ctx context.Context, slots []entities.Slot) error {
+	location := config.Location()
+
+	// Collect the days of every game the slots were generated on, keeping the order in which they were generated
+	type gameDay struct {
+		gameID uuid.UUID
+		date   string
+	}
+	var days []gameDay
+	dates := make(map[gameDay]time.Time)
+	for _, slot := range slots {
+		key := gameDay{gameID: slot.GameID, date: slot.StartTime.In(location).Format("2006-01-02")}
+		if _, ok := dates[key]; !ok {
+			days = append(days, key)
+			dates[key] = slot.StartTime.In(location)
+		}
+	}
+
+	for _, key := range days {
+		date := dates[key]
+
+		recurrences, err := r.recurringRepo.FetchActiveRecurringBookingsByGameID(ctx, key.gameID, date)
+		if err != nil {
+			return err
+		}
+		if len(recurrences) == 0 {
+			continue
+		}
+
+		game, err := r.GameService.GetGameByID(ctx, key.gameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game details: %w", err)
+		}
+		if game == nil {
+			continue
+		}
+
+		daySlots, err := r.SlotService.GetGameSlotsByDate(ctx, key.gameID, date)
+		if err != nil {
+			return fmt.Errorf("failed to fetch slots: %w", err)
+		}
+
+		for i := range recurrences {
+			if !occursOn(&recurrences[i], date) {
+				continue
+			}
+
+			conflict, err := r.bookOccurrence(ctx, &recurrences[i], game, date, daySlots, location)
+			if err != nil {
+				return err
+			}
+			if conflict == nil {
+				continue
+			}
+
+			message := fmt.Sprintf("Your recurring %s booking on %s at %s could not be made: %s.",
+				conflict.GameName, utils.FormatDay(conflict.StartTime), recurrences[i].StartTime, conflict.Reason)
+			if err := r.NotificationService.NotifyUser(ctx, recurrences[i].UserID, message); err != nil {
+				return fmt.Errorf("failed to notify user of recurring booking conflict: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// bookOccurrence books the user into the first table with a free seat at the recurrence's start time.
+// It returns a conflict if no such slot exists or every table is full. A user who already holds a seat at
+// that time in the game, even one booked meanwhile, has their occurrence and gets no conflict.
+func (r *RecurringBookingService) bookOccurrence(ctx context.Context, recurrence *entities.RecurringBooking, game *entities.Game, date time.Time, slots []entities.Slot, location *time.Location) (*models.BookingConflict, error) {
+	var candidates []entities.Slot
+	for _, slot := range slots {
+		if slot.StartTime.In(location).Format("15:04") == recurrence.StartTime {
+			candidates = append(candidates, slot)
+		}
+	}
+	if len(candidates) == 0 {
+		startTime, _ := time.ParseInLocation("15:04", recurrence.StartTime, location)
+		return &models.BookingConflict{
+			GameName:  game.GameName,
+			StartTime: time.Date(date.Year(), date.Month(), date.Day(), startTime.Hour(), startTime.Minute(), 0, 0, location),
+			Reason:    "no slot starts at that time",
+		}, nil
+	}
+
+	// Occurrences that have already started are skipped, as are ones the user already holds a seat in
+	if candidates[0].StartTime.Before(time.Now()) {
+		return nil, nil
+	}
+	for _, slot := range candidates {
+		booking, err := r.BookingService.GetBookingByUserAndSlotID(ctx, recurrence.UserID, slot.SlotID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing booking: %w", err)
+		}
+		if booking.BookingId != uuid.Nil {
+			return nil, nil
+		}
+	}
+
+	for _, slot := range candidates {
+		err := r.BookingService.MakeBooking(ctx, recurrence.UserID, slot.SlotID)
+		var overlap *domain_errors.BookingOverlapError
+		switch {
+		case err == nil, errors.Is(err, domain_errors.ErrAlreadyBooked):
+			return nil, nil
+		case errors.Is(err, domain_errors.ErrSlotFull):
+			continue
+		case errors.As(err, &overlap) && overlap.GameName == game.GameName && overlap.StartTime.Equal(slot.StartTime):
+			// The overlapping booking is the user's seat at another table of the same occurrence
+			return nil, nil
+		case errors.As(err, &overlap), errors.Is(err, domain_errors.ErrGameRoomClosed):
+			return &models.BookingConflict{GameName: game.GameName, StartTime: slot.StartTime, Reason: err.Error()}, nil
+		default:
+			return nil, fmt.Errorf("failed to book recurring occurrence: %w", err)
+		}
+	}
+
+	return &models.BookingConflict{
+		GameName:  game.GameName,
+		StartTime: candidates[0].StartTime,
+		Reason:    "the slot is already full",
+	}, nil
+}
+
+// occursOn reports whether the recurrence has an occurrence on the given date
+func occursOn(recurrence *entities.RecurringBooking, date time.Time) bool {
+	if date.Format("2006-01-02") > recurrence.EndDate.Format("2006-01-02") {
+		return false
+	}
+	for _, weekday := range recurrence.Weekdays {
+		if weekday == date.Weekday() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/internal/models"
+	"project2/pkg/utils"
+	"sort"
+	"time"
+)
+
+type ResultService struct {
+	resultRepo          repository_interfaces.ResultRepository
+	SlotService         service_interfaces.SlotService
+	GameService         service_interfaces.GameService
+	LeaderboardService  service_interfaces.LeaderboardService
+	TeamService         service_interfaces.TeamService
+	NotificationService service_interfaces.NotificationService
+}
+
+func NewResultService(resultRepo repository_interfaces.ResultRepository, slotService service_interfaces.SlotService, gameService service_interfaces.GameService, leaderboardService service_interfaces.LeaderboardService, teamService service_interfaces.TeamService, notificationService service_interfaces.NotificationService) service_interfaces.ResultService {
+	return &ResultService{
+		resultRepo:          resultRepo,
+		SlotService:         slotService,
+		GameService:         gameService,
+		LeaderboardService:  leaderboardService,
+		TeamService:         teamService,
+		NotificationService: notificationService,
+	}
+}
+
+// GetUnreportedMatches returns the user's finished matches that still need a result
+func (r *ResultService) GetUnreportedMatches(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error) {
+	return r.resultRepo.FetchUnreportedMatches(ctx, userID, utils.Now())
+}
+
+func (r *ResultService) GetMatchParticipants(ctx context.Context, slotID uuid.UUID) ([]models.MatchParticipant, error) {
+	return r.resultRepo.FetchMatchParticipants(ctx, slotID)
+}
+
+// ReportResult records the outcome of every player of the slot's finished match as reported by one of them and
+// asks the other players to confirm it. The leaderboard is not updated until the match is confirmed.
+func (r *ResultService) ReportResult(ctx context.Context, reporterID, slotID uuid.UUID, players []entities.MatchPlayer) (uuid.UUID, error) {
+	slot, err := r.SlotService.GetSlotByID(ctx, slotID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return uuid.Nil, errors.New("slot not found")
+	}
+	if slot.EndTime.After(utils.Now()) {
+		return uuid.Nil, errors.New("the match has not finished yet")
+	}
+
+	participants, err := r.resultRepo.FetchMatchParticipants(ctx, slotID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	reporter, ok := findParticipant(participants, reporterID)
+	if !ok {
+		return uuid.Nil, domain_errors.ErrNotAParticipant
+	}
+	if err := validateMatchPlayers(participants, players); err != nil {
+		return uuid.Nil, err
+	}
+
+	match := &entities.Match{
+		SlotID:     slotID,
+		ReportedBy: reporterID,
+		ConfirmBy:  utils.Now().Add(time.Duration(config.ResultConfirmationHours) * time.Hour),
+		Players:    players,
+	}
+	id, err := r.resultRepo.CreateMatch(ctx, match)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	game, err := r.GameService.GetGameByID(ctx, slot.GameID)
+	if err != nil {
+		return id, fmt.Errorf("result reported but failed to get game details: %w", err)
+	}
+
+	outcome := utils.FormatMatchResult(matchPlayerResults(participants, players))
+	for _, participant := range participants {
+		if participant.UserId == reporterID {
+			continue
+		}
+		message := fmt.Sprintf("%s reported the result of your %s match on %s at %s: %s. Confirm or dispute it from Update Results by %s %s, after which it is confirmed automatically.",
+			reporter.Username, game.GameName, utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime), outcome,
+			utils.FormatDay(match.ConfirmBy), utils.FormatClock(match.ConfirmBy))
+		if err := r.NotificationService.NotifyUser(ctx, participant.UserId, message); err != nil {
+			return id, fmt.Errorf("result reported but failed to notify the other players: %w", err)
+		}
+	}
+
+	return id, nil
+}
+
+func (r *ResultService) GetResultsAwaitingConfirmation(ctx context.Context, userID uuid.UUID) ([]models.ResultReport, error) {
+	return r.resultRepo.FetchResultsAwaitingConfirmation(ctx, userID)
+}
+
+// RespondToResult confirms or disputes a pending match on behalf of one of its players. The leaderboard is
+// updated once the last player confirms it, or by the next confirmation job if that fails. A dispute is reported back to the player who reported the match.
+func (r *ResultService) RespondToResult(ctx context.Context, userID, matchID uuid.UUID, confirmed bool) error {
+	status, err := r.resultRepo.RespondToResult(ctx, matchID, userID, confirmed)
+	if err != nil {
+		return err
+	}
+	if status == entities.ResultPending {
+		return nil
+	}
+
+	match, err := r.resultRepo.FetchMatchByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if match == nil {
+		return fmt.Errorf("no match found with ID %s", matchID)
+	}
+
+	if status == entities.ResultConfirmed {
+		return r.applyResult(ctx, match)
+	}
+
+	slot, err := r.SlotService.GetSlotByID(ctx, match.SlotID)
+	if err != nil {
+		return fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return errors.New("slot not found")
+	}
+	message := fmt.Sprintf("The result you reported for your match on %s at %s has been disputed. An admin will decide the outcome.",
+		utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime))
+	if err := r.NotificationService.NotifyUser(ctx, match.ReportedBy, message); err != nil {
+		return fmt.Errorf("result disputed but failed to notify the reporter: %w", err)
+	}
+	return nil
+}
+
+func (r *ResultService) GetDisputedResults(ctx context.Context) ([]models.ResultReport, error) {
+	return r.resultRepo.FetchDisputedResults(ctx)
+}
+
+// ResolveDispute settles a disputed match with the outcomes decided by an admin and updates the leaderboard
+func (r *ResultService) ResolveDispute(ctx context.Context, matchID uuid.UUID, players []entities.MatchPlayer) error {
+	match, err := r.resultRepo.FetchMatchByID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if match == nil {
+		return fmt.Errorf("no match found with ID %s", matchID)
+	}
+	if match.Status != entities.ResultDisputed {
+		return domain_errors.ErrResultNotDisputed
+	}
+
+	participants, err := r.resultRepo.FetchMatchParticipants(ctx, match.SlotID)
+	if err != nil {
+		return err
+	}
+	if err := validateMatchPlayers(participants, players); err != nil {
+		return err
+	}
+
+	if err := r.resultRepo.ResolveDispute(ctx, matchID, players); err != nil {
+		return err
+	}
+	match.Players = players
+	match.Status = entities.ResultConfirmed
+	return r.applyResult(ctx, match)
+}
+
+// ConfirmExpiredResults confirms the pending matches whose confirmation deadline has passed, then counts every
+// confirmed match that is not in the leaderboard yet. This includes the matches whose result could not be applied
+// when they were confirmed, so a failed apply is retried on the next run. A match that fails again does not hold
+// up the others. It returns the number of matches applied.
+func (r *ResultService) ConfirmExpiredResults(ctx context.Context) (int, error) {
+	if _, err := r.resultRepo.ConfirmExpiredResults(ctx, utils.Now()); err != nil {
+		return 0, err
+	}
+
+	matchIDs, err := r.resultRepo.FetchUnappliedMatchIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var applied int
+	var errs []error
+	for _, matchID := range matchIDs {
+		match, err := r.resultRepo.FetchMatchByID(ctx, matchID)
+		if err == nil && match == nil {
+			err = fmt.Errorf("no match found with ID %s", matchID)
+		}
+		if err == nil {
+			err = r.applyResult(ctx, match)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply match %s: %w", matchID, err))
+			continue
+		}
+		applied++
+	}
+	return applied, errors.Join(errs...)
+}
+
+// applyResult derives the booking results, leaderboard stats and ratings of every player and of every registered
+// team from a confirmed match and saves them together with the applied mark of the match, so the match is counted
+// exactly once. Players and teams are scored from the stats read within that transaction, so that matches of the
+// same game applied at the same time do not overwrite each other's updates. The players are then told the result is
+// final. A match that was applied concurrently is skipped.
+func (r *ResultService) applyResult(ctx context.Context, match *entities.Match) error {
+	slot, err := r.SlotService.GetSlotByID(ctx, match.SlotID)
+	if err != nil {
+		return fmt.Errorf("failed to get slot details: %w", err)
+	}
+	if slot == nil {
+		return errors.New("slot not found")
+	}
+	game, err := r.GameService.GetGameByID(ctx, slot.GameID)
+	if err != nil {
+		return fmt.Errorf("failed to get game details: %w", err)
+	}
+
+	scoreStandings := func(standings []*entities.Leaderboard) ([]*entities.Leaderboard, error) {
+		return r.LeaderboardService.ScoreMatch(ctx, slot.GameID, standings, match.Players)
+	}
+	rateTeams := func(teams []entities.Team) ([]*entities.Team, error) {
+		return r.TeamService.RateMatch(ctx, teams, match.Players)
+	}
+	if err := r.resultRepo.ApplyMatch(ctx, match.MatchID, slot.GameID, scoreStandings, rateTeams); err != nil {
+		if errors.Is(err, domain_errors.ErrResultAlreadyApplied) {
+			return nil
+		}
+		return err
+	}
+
+	participants, err := r.resultRepo.FetchMatchParticipants(ctx, match.SlotID)
+	if err != nil {
+		return err
+	}
+	outcome := utils.FormatMatchResult(matchPlayerResults(participants, match.Players))
+	for _, player := range match.Players {
+		message := fmt.Sprintf("The result of your %s match on %s at %s is confirmed: %s.",
+			game.GameName, utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime), outcome)
+		if err := r.NotificationService.NotifyUser(ctx, player.UserID, message); err != nil {
+			return fmt.Errorf("result confirmed but failed to notify the players: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateMatchPlayers checks that the reported players are exactly the players booked into the match, that
+// players on the same side share its outcome and score, and that either every side drew or exactly one side won.
+// The booking of every player is filled in from the participants.
+func validateMatchPlayers(participants []models.MatchParticipant, players []entities.MatchPlayer) error {
+	if len(participants) < 2 {
+		return errors.New("a result can only be reported for a match with at least two players")
+	}
+	if len(players) != len(participants) {
+		return errors.New("every player of the match needs an outcome")
+	}
+
+	type side struct {
+		outcome string
+		score   *int
+	}
+	sides := make(map[int]side)
+	seen := make(map[uuid.UUID]bool)
+	for i, player := range players {
+		participant, ok := findParticipant(participants, player.UserID)
+		if !ok || seen[player.UserID] {
+			return errors.New("every player of the match needs exactly one outcome")
+		}
+		seen[player.UserID] = true
+		players[i].BookingID = participant.BookingId
+
+		if player.Outcome != entities.OutcomeWin && player.Outcome != entities.OutcomeLoss && player.Outcome != entities.OutcomeDraw {
+			return fmt.Errorf("invalid outcome %q", player.Outcome)
+		}
+		if player.Side < 1 {
+			return errors.New("sides must be numbered from 1")
+		}
+		if player.Score != nil && *player.Score < 0 {
+			return errors.New("scores cannot be negative")
+		}
+
+		existing, ok := sides[player.Side]
+		if !ok {
+			sides[player.Side] = side{outcome: player.Outcome, score: player.Score}
+			continue
+		}
+		if existing.outcome != player.Outcome || !sameScore(existing.score, player.Score) {
+			return errors.New("players on the same side must share its outcome and score")
+		}
+	}
+
+	if len(sides) < 2 {
+		return errors.New("a match needs at least two sides")
+	}
+	var winners, draws, scored int
+	for _, s := range sides {
+		switch s.outcome {
+		case entities.OutcomeWin:
+			winners++
+		case entities.OutcomeDraw:
+			draws++
+		}
+		if s.score != nil {
+			scored++
+		}
+	}
+	if draws > 0 && draws != len(sides) {
+		return errors.New("either every side draws or none does")
+	}
+	if draws == 0 && winners != 1 {
+		return errors.New("exactly one side must win a match that is not a draw")
+	}
+	if scored > 0 && scored != len(sides) {
+		return errors.New("scores must be given for every side or for none")
+	}
+	return nil
+}
+
+func sameScore(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// matchPlayerResults pairs the outcome of every player with their username, ordered by side
+func matchPlayerResults(participants []models.MatchParticipant, players []entities.MatchPlayer) []models.MatchPlayerResult {
+	results := make([]models.MatchPlayerResult, 0, len(players))
+	for _, player := range players {
+		participant, _ := findParticipant(participants, player.UserID)
+		results = append(results, models.MatchPlayerResult{
+			Username: participant.Username,
+			Side:     player.Side,
+			Outcome:  player.Outcome,
+			Score:    player.Score,
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Side < results[j].Side })
+	return results
+}
+
+// findParticipant returns the player of the match with the given user ID
+func findParticipant(participants []models.MatchParticipant, userID uuid.UUID) (models.MatchParticipant, bool) {
+	for _, participant := range participants {
+		if participant.UserId == userID {
+			return participant, true
+		}
+	}
+	return models.MatchParticipant{}, false
+}
@@ -2,11 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
 	repository_interfaces "project2/internal/domain/interfaces/repository"
 	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/internal/models"
+	"project2/pkg/utils"
 	"sync"
 	"time"
 )
@@ -25,15 +29,57 @@ func NewSlotService(slotRepo repository_interfaces.SlotRepository) service_inter
 
 // GetCurrentDayGameSlots retrieves all slots for the current day for a specific game.
 func (s *SlotService) GetCurrentDayGameSlots(ctx context.Context, gameID uuid.UUID) ([]entities.Slot, error) {
+	return s.GetGameSlotsByDate(ctx, gameID, utils.Now())
+}
+
+// GetGameSlotsByDate retrieves all slots of a specific game on the given date.
+func (s *SlotService) GetGameSlotsByDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]entities.Slot, error) {
 
 	// Call the repository to fetch slots by game ID and date
-	slots, err := s.slotRepo.FetchSlotsByGameIDAndDate(ctx, gameID, time.Now())
+	slots, err := s.slotRepo.FetchSlotsByGameIDAndDate(ctx, gameID, date)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch slots for game ID %s on date %s: %w", gameID, time.Now().Format("2006-03-04"), err)
+		return nil, fmt.Errorf("failed to fetch slots for game ID %s on date %s: %w", gameID, date.Format("2006-01-02"), err)
 	}
 
 	return slots, nil
 }
+
+// GetGameSlotOccupancy retrieves all slots of a specific game on the given date with their booked count and capacity.
+func (s *SlotService) GetGameSlotOccupancy(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error) {
+	slots, err := s.slotRepo.FetchSlotOccupancyByGameIDAndDate(ctx, gameID, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch slot occupancy for game ID %s on date %s: %w", gameID, date.Format("2006-01-02"), err)
+	}
+	return slots, nil
+}
+
+// GetJoinableGameSlots retrieves the upcoming slots of a specific game on the given date that have
+// players waiting for opponents, i.e. that are partially booked.
+func (s *SlotService) GetJoinableGameSlots(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error) {
+	slots, err := s.GetGameSlotOccupancy(ctx, gameID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	now := utils.Now()
+	var joinable []models.SlotOccupancy
+	for _, slot := range slots {
+		if slot.IsWaitingForOpponents() && slot.StartTime.After(now) {
+			joinable = append(joinable, slot)
+		}
+	}
+	return joinable, nil
+}
+
+// GetSlotOccupancyByDate retrieves the slots of every game on the given date with their booked count and capacity.
+func (s *SlotService) GetSlotOccupancyByDate(ctx context.Context, date time.Time) ([]models.SlotOccupancy, error) {
+	slots, err := s.slotRepo.FetchSlotOccupancyByDate(ctx, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch slots on date %s: %w", date.Format("2006-01-02"), err)
+	}
+	return slots, nil
+}
+
 func (s *SlotService) GetSlotByID(ctx context.Context, slotID uuid.UUID) (*entities.Slot, error) {
 	return s.slotRepo.FetchSlotByID(ctx, slotID)
 }
@@ -41,3 +87,32 @@ func (s *SlotService) GetSlotByID(ctx context.Context, slotID uuid.UUID) (*entit
 func (s *SlotService) MarkSlotAsBooked(ctx context.Context, slotID uuid.UUID) error {
 	return s.slotRepo.UpdateSlotStatus(ctx, slotID, true)
 }
+
+// MarkSlotAsAvailable reopens a slot so that it can be booked again.
+func (s *SlotService) MarkSlotAsAvailable(ctx context.Context, slotID uuid.UUID) error {
+	return s.slotRepo.UpdateSlotStatus(ctx, slotID, false)
+}
+
+// FindFreeSlots returns the soonest slots, across every active game, with enough free seats for the search.
+// The search never looks before now nor past the booking horizon, and asks for one seat unless told otherwise.
+func (s *SlotService) FindFreeSlots(ctx context.Context, search models.SlotSearch) ([]models.FreeSlot, error) {
+	now := utils.Now()
+	if search.EarliestStart.Before(now) {
+		search.EarliestStart = now
+	}
+	horizon := utils.StartOfDay(now).AddDate(0, 0, config.BookingHorizonDays)
+	if search.LatestStart.IsZero() || search.LatestStart.After(horizon) {
+		search.LatestStart = horizon
+	}
+	if search.LatestStart.Before(search.EarliestStart) {
+		return nil, errors.New("the latest start cannot be before the earliest start")
+	}
+	if search.SeatsNeeded < 1 {
+		search.SeatsNeeded = 1
+	}
+	if search.Limit < 1 {
+		search.Limit = config.FreeSlotSearchLimit
+	}
+
+	return s.slotRepo.FetchFreeSlots(ctx, search)
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	service_interfaces "project2/internal/domain/interfaces/service"
+	"project2/internal/models"
+	"project2/pkg/rating"
+	"sort"
+	"strings"
+)
+
+type TeamService struct {
+	teamRepo    repository_interfaces.TeamRepository
+	UserService service_interfaces.UserService
+	GameService service_interfaces.GameService
+}
+
+func NewTeamService(teamRepo repository_interfaces.TeamRepository, userService service_interfaces.UserService, gameService service_interfaces.GameService) service_interfaces.TeamService {
+	return &TeamService{
+		teamRepo:    teamRepo,
+		UserService: userService,
+		GameService: gameService,
+	}
+}
+
+// CreateTeam registers a fixed team of the creator and the given players, identified by username or email.
+// Two teams must fit into a single slot of the game, and no two teams of a game may share their name or players.
+func (t *TeamService) CreateTeam(ctx context.Context, creatorID, gameID uuid.UUID, name string, members []string) (uuid.UUID, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return uuid.Nil, errors.New("team name cannot be empty")
+	}
+	if len(name) > 50 {
+		return uuid.Nil, errors.New("team name cannot be longer than 50 characters")
+	}
+
+	game, err := t.GameService.GetGameByID(ctx, gameID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get game details: %w", err)
+	}
+	if game == nil {
+		return uuid.Nil, errors.New("game not found")
+	}
+	if game.MaxPlayers < 4 {
+		return uuid.Nil, fmt.Errorf("%s is not played in teams", game.GameName)
+	}
+
+	memberIDs := []uuid.UUID{creatorID}
+	for _, identifier := range members {
+		user, err := t.findMember(ctx, strings.TrimSpace(identifier))
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if !containsUser(memberIDs, user.UserID) {
+			memberIDs = append(memberIDs, user.UserID)
+		}
+	}
+	if len(memberIDs) < 2 {
+		return uuid.Nil, errors.New("a team needs at least two players")
+	}
+	if len(memberIDs) > game.MaxPlayers/2 {
+		return uuid.Nil, fmt.Errorf("a team of %s can have at most %d players", game.GameName, game.MaxPlayers/2)
+	}
+
+	teams, err := t.teamRepo.FetchGameTeams(ctx, gameID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return uuid.Nil, domain_errors.ErrTeamNameTaken
+		}
+		if sameMembers(team.Members, memberIDs) {
+			return uuid.Nil, domain_errors.ErrTeamAlreadyExists
+		}
+	}
+
+	team := &entities.Team{
+		GameID:          gameID,
+		Name:            name,
+		CreatedBy:       creatorID,
+		Members:         memberIDs,
+		Rating:          config.InitialRating,
+		RatingDeviation: config.InitialRatingDeviation,
+		Volatility:      config.InitialVolatility,
+	}
+	return t.teamRepo.CreateTeam(ctx, team)
+}
+
+func (t *TeamService) GetUserTeams(ctx context.Context, userID uuid.UUID) ([]models.Team, error) {
+	return t.teamRepo.FetchUserTeams(ctx, userID)
+}
+
+func (t *TeamService) GetTeamLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.TeamLeaderboard, error) {
+	return t.teamRepo.FetchTeamLeaderboard(ctx, gameID)
+}
+
+// DisbandTeam deletes the team together with its record. Only members of the team may disband it.
+func (t *TeamService) DisbandTeam(ctx context.Context, userID, teamID uuid.UUID) error {
+	return t.teamRepo.DeleteTeam(ctx, teamID, userID)
+}
+
+// RateMatch credits the outcome of a confirmed match to every side made up of exactly the members of one of the
+// given teams, which are all the teams of the game with their current records and ratings. It returns those teams
+// without saving them, so that they can be saved together with the match.
+// Teams are rated against the other teams of the match only, since sides of players who did not register
+// as a team have no team rating.
+func (t *TeamService) RateMatch(ctx context.Context, teams []entities.Team, players []entities.MatchPlayer) ([]*entities.Team, error) {
+	if len(teams) == 0 {
+		return nil, nil
+	}
+
+	members := make(map[int][]uuid.UUID)
+	outcomes := make(map[int]string)
+	for _, player := range players {
+		members[player.Side] = append(members[player.Side], player.UserID)
+		outcomes[player.Side] = player.Outcome
+	}
+	sides := make([]int, 0, len(members))
+	for side := range members {
+		sides = append(sides, side)
+	}
+	sort.Ints(sides)
+
+	type teamSide struct {
+		team    *entities.Team
+		outcome string
+		before  rating.Rating
+	}
+	var matched []teamSide
+	for _, side := range sides {
+		for i := range teams {
+			if sameMembers(teams[i].Members, members[side]) {
+				team := &teams[i]
+				before := rating.Rating{Value: team.Rating, Deviation: team.RatingDeviation, Volatility: team.Volatility}
+				matched = append(matched, teamSide{team: team, outcome: outcomes[side], before: before})
+				break
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+
+	system, err := rating.New(config.RatingSystem, config.EloKFactor, config.GlickoTau)
+	if err != nil {
+		return nil, err
+	}
+	rated := make([]*entities.Team, 0, len(matched))
+	for i, side := range matched {
+		var results []rating.Result
+		for j, opponent := range matched {
+			if i == j {
+				continue
+			}
+			if score, ok := pairingScore(side.outcome, opponent.outcome); ok {
+				results = append(results, rating.Result{Opponent: opponent.before, Score: score})
+			}
+		}
+		if len(results) > 0 {
+			after := system.Update(side.before, results)
+			side.team.Rating, side.team.RatingDeviation, side.team.Volatility = after.Value, after.Deviation, after.Volatility
+		}
+
+		switch side.outcome {
+		case entities.OutcomeWin:
+			side.team.Wins++
+		case entities.OutcomeLoss:
+			side.team.Losses++
+		case entities.OutcomeDraw:
+			side.team.Draws++
+		default:
+			return nil, fmt.Errorf("invalid outcome %q", side.outcome)
+		}
+		rated = append(rated, side.team)
+	}
+	return rated, nil
+}
+
+// findMember looks a user up by email, or by username if the identifier is not an email address
+func (t *TeamService) findMember(ctx context.Context, identifier string) (*entities.User, error) {
+	var user *entities.User
+	var err error
+	if strings.Contains(identifier, "@") {
+		user, err = t.UserService.GetUserByEmail(ctx, identifier)
+	} else {
+		user, err = t.UserService.GetUserByUsername(ctx, identifier)
+	}
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("cannot find user %q", identifier)
+	}
+	return user, nil
+}
+
+func containsUser(users []uuid.UUID, userID uuid.UUID) bool {
+	for _, user := range users {
+		if user == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// sameMembers reports whether both lists hold the same players, in any order
+func sameMembers(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, user := range a {
+		if !containsUser(b, user) {
+			return false
+		}
+	}
+	return true
+}
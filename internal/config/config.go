@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 var (
 	Host     = "localhost"
 	Port     = 5432
@@ -7,3 +9,79 @@ var (
 	Password = "password"
 	Dbname   = "play-hub"
 )
+
+// BookingHorizonDays is the number of days, starting today, for which slots are generated and can be booked
+var BookingHorizonDays = 7
+
+// TimeZone is the location in which the slots are generated, the booking days start and end and all times are displayed
+var TimeZone = "Asia/Kolkata"
+
+// Location returns the TimeZone location, falling back to UTC if the zone is unknown.
+// The application refuses to start with an unknown zone, so the fallback is never used at runtime.
+func Location() *time.Location {
+	location, err := time.LoadLocation(TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// WorkingDays are the days of the week on which the game room is open and slots are generated
+var WorkingDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// Default schedule used for games that do not define their own
+var (
+	DefaultOpenTime     = "09:00"
+	DefaultCloseTime    = "18:00"
+	DefaultSlotDuration = 20
+)
+
+// FreeSlotSearchLimit is the number of slots returned by a free slot search
+var FreeSlotSearchLimit = 5
+
+// MinPlayersCheckMinutes is how many minutes before a slot starts its bookings are cancelled
+// if the slot has not reached the game's minimum number of players
+var MinPlayersCheckMinutes = 15
+
+// CheckInWindowMinutes is how many minutes before and after a slot starts its players can check in.
+// Bookings that are not checked in by the end of the window are marked as no-shows.
+var CheckInWindowMinutes = 10
+
+// JobInterval is how often the background jobs run
+var JobInterval = time.Minute
+
+// SlotGenerationTime is the time of day, in TimeZone, at which the slots of the booking horizon are generated
+var SlotGenerationTime = "00:05"
+
+// ResultConfirmationHours is how many hours the other players of a match have to confirm or dispute a reported
+// result. Results that are not disputed by then are confirmed automatically.
+var ResultConfirmationHours = 24
+
+// RatingSystem is the system used to rate the players of every game from their confirmed matches, "elo" or "glicko2"
+var RatingSystem = "glicko2"
+
+// Rating given to a player before their first match of a game, with its deviation and volatility under Glicko-2
+var (
+	InitialRating          = 1500.0
+	InitialRatingDeviation = 350.0
+	InitialVolatility      = 0.06
+)
+
+// EloKFactor is the largest change of an Elo rating in a single match
+var EloKFactor = 32.0
+
+// GlickoTau constrains how quickly Glicko-2 volatilities change
+var GlickoTau = 0.5
+
+// ProvisionalGames is the number of games a player must play in a game before their rating is considered established
+var ProvisionalGames = 10
+
+// DefaultScoringStrategy is how the leaderboards of new games are scored unless the admin picks another strategy
+var DefaultScoringStrategy = "rating"
+
+// Points awarded for a win, a draw and a loss in games scored with the points strategy
+var (
+	PointsPerWin  = 3.0
+	PointsPerDraw = 1.0
+	PointsPerLoss = 0.0
+)
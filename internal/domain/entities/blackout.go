@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Blackout closes a game, or every game when GameID is nil, between StartTime and EndTime
+type Blackout struct {
+	BlackoutID uuid.UUID  `json:"blackout_id" db:"blackout_id"`
+	GameID     *uuid.UUID `json:"game_id,omitempty" db:"game_id"`
+	StartTime  time.Time  `json:"start_time" db:"start_time"`
+	EndTime    time.Time  `json:"end_time" db:"end_time"`
+	Reason     string     `json:"reason" db:"reason"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Covers reports whether the blackout closes the game for any part of the given time range.
+func (b Blackout) Covers(gameID uuid.UUID, start, end time.Time) bool {
+	if b.GameID != nil && *b.GameID != gameID {
+		return false
+	}
+	return start.Before(b.EndTime) && end.After(b.StartTime)
+}
@@ -5,10 +5,22 @@ import (
 	"time"
 )
 
+// Attendance states of a booking
+const (
+	AttendancePending   = "pending"
+	AttendanceCheckedIn = "checked_in"
+	AttendanceNoShow    = "no_show"
+	// AttendanceAttended is given to the bookings made before attendance was tracked
+	AttendanceAttended = "attended"
+)
+
 type Booking struct {
-	BookingID uuid.UUID `json:"booking_id" db:"booking_id"`
-	SlotID    uuid.UUID `json:"slot_id" db:"slot_id"`
-	UserID    uuid.UUID `json:"user_id" db:"user_id"`
-	Result    string    `json:"result" db:"result"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	BookingID   uuid.UUID  `json:"booking_id" db:"booking_id"`
+	SlotID      uuid.UUID  `json:"slot_id" db:"slot_id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Result      string     `json:"result" db:"result"`
+	CheckInCode string     `json:"check_in_code" db:"check_in_code"`
+	Attendance  string     `json:"attendance" db:"attendance"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty" db:"checked_in_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
 }
@@ -6,12 +6,25 @@ import (
 )
 
 type Game struct {
-	GameID     uuid.UUID `json:"game_id" db:"game_id"`
-	GameName   string    `json:"game_name" db:"game_name"`
-	MinPlayers int       `json:"min_players" db:"min_players"`
-	MaxPlayers int       `json:"max_players" db:"max_players"`
-	Instances  int       `json:"instances" db:"instances"`
-	IsActive   bool      `json:"is_active" db:"is_active"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	GameID           uuid.UUID                     `json:"game_id" db:"game_id"`
+	GameName         string                        `json:"game_name" db:"game_name"`
+	MinPlayers       int                           `json:"min_players" db:"min_players"`
+	MaxPlayers       int                           `json:"max_players" db:"max_players"`
+	Instances        int                           `json:"instances" db:"instances"`
+	OpenTime         string                        `json:"open_time" db:"open_time"`
+	CloseTime        string                        `json:"close_time" db:"close_time"`
+	SlotDuration     int                           `json:"slot_duration" db:"slot_duration"`
+	WeekdaySchedules map[time.Weekday]GameSchedule `json:"weekday_schedules,omitempty" db:"weekday_schedules"`
+	IsActive         bool                          `json:"is_active" db:"is_active"`
+	ScoringStrategy  string                        `json:"scoring_strategy" db:"scoring_strategy"`
+	CreatedAt        time.Time                     `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time                     `json:"updated_at" db:"updated_at"`
+}
+
+// GameSchedule holds the operating hours of a game and the length of its slots.
+// Times are in HH:MM format and the slot duration is in minutes.
+type GameSchedule struct {
+	OpenTime     string `json:"open_time"`
+	CloseTime    string `json:"close_time"`
+	SlotDuration int    `json:"slot_duration"`
 }
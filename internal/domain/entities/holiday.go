@@ -0,0 +1,10 @@
+package entities
+
+import "time"
+
+// Holiday is a day on which the game room is closed for every game
+type Holiday struct {
+	Date      time.Time `json:"holiday_date" db:"holiday_date"`
+	Name      string    `json:"name" db:"name"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
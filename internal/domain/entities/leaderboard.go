@@ -6,11 +6,15 @@ import (
 )
 
 type Leaderboard struct {
-	ScoreID   uuid.UUID `json:"score_id" db:"score_id"`
-	UserID    uuid.UUID `json:"user_id" db:"user_id"`
-	GameID    uuid.UUID `json:"game_id" db:"game_id"`
-	Wins      int       `json:"wins" db:"wins"`
-	Losses    int       `json:"losses" db:"losses"`
-	Score     float64   `json:"score" db:"score"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	ScoreID         uuid.UUID `json:"score_id" db:"score_id"`
+	UserID          uuid.UUID `json:"user_id" db:"user_id"`
+	GameID          uuid.UUID `json:"game_id" db:"game_id"`
+	Wins            int       `json:"wins" db:"wins"`
+	Losses          int       `json:"losses" db:"losses"`
+	Draws           int       `json:"draws" db:"draws"`
+	Score           float64   `json:"score" db:"score"`
+	Rating          float64   `json:"rating" db:"rating"`
+	RatingDeviation float64   `json:"rating_deviation" db:"rating_deviation"`
+	Volatility      float64   `json:"volatility" db:"volatility"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
 }
@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// States of a reported match and of each player's response to it
+const (
+	ResultPending   = "pending"
+	ResultConfirmed = "confirmed"
+	ResultDisputed  = "disputed"
+)
+
+// Outcomes of a player in a match
+const (
+	OutcomeWin  = "win"
+	OutcomeLoss = "loss"
+	OutcomeDraw = "draw"
+)
+
+// Match is the result of the game played in a slot, as reported by one of its players. The leaderboard is only
+// updated once the other players confirm it, an admin resolves a dispute over it or ConfirmBy passes without a dispute.
+// AppliedAt is set once the confirmed result has been counted in the leaderboard.
+type Match struct {
+	MatchID    uuid.UUID     `json:"match_id" db:"match_id"`
+	SlotID     uuid.UUID     `json:"slot_id" db:"slot_id"`
+	ReportedBy uuid.UUID     `json:"reported_by" db:"reported_by"`
+	Status     string        `json:"status" db:"status"`
+	ConfirmBy  time.Time     `json:"confirm_by" db:"confirm_by"`
+	ResolvedAt *time.Time    `json:"resolved_at,omitempty" db:"resolved_at"`
+	AppliedAt  *time.Time    `json:"applied_at,omitempty" db:"applied_at"`
+	CreatedAt  time.Time     `json:"created_at" db:"created_at"`
+	Players    []MatchPlayer `json:"players"`
+}
+
+// MatchPlayer is the outcome of one player of a match. Players on the same side share its outcome and score.
+type MatchPlayer struct {
+	MatchID   uuid.UUID `json:"match_id" db:"match_id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	BookingID uuid.UUID `json:"booking_id" db:"booking_id"`
+	Side      int       `json:"side" db:"side"`
+	Outcome   string    `json:"outcome" db:"outcome"`
+	Score     *int      `json:"score,omitempty" db:"score"`
+}
+
+// ResultConfirmation is a player's response to a reported match
+type ResultConfirmation struct {
+	MatchID     uuid.UUID  `json:"match_id" db:"match_id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Status      string     `json:"status" db:"status"`
+	RespondedAt *time.Time `json:"responded_at,omitempty" db:"responded_at"`
+}
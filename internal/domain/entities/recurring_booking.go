@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// RecurringBooking books the user into the game at the same time on every selected weekday until EndDate
+type RecurringBooking struct {
+	RecurrenceID uuid.UUID      `json:"recurrence_id" db:"recurrence_id"`
+	UserID       uuid.UUID      `json:"user_id" db:"user_id"`
+	GameID       uuid.UUID      `json:"game_id" db:"game_id"`
+	Weekdays     []time.Weekday `json:"weekdays" db:"weekdays"`
+	StartTime    string         `json:"start_time" db:"start_time"`
+	EndDate      time.Time      `json:"end_date" db:"end_date"`
+	CreatedAt    time.Time      `json:"created_at" db:"created_at"`
+}
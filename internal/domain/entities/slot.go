@@ -8,6 +8,7 @@ import (
 type Slot struct {
 	SlotID    uuid.UUID `json:"slot_id" db:"slot_id"`
 	GameID    uuid.UUID `json:"game_id" db:"game_id"`
+	Instance  int       `json:"instance" db:"instance"`
 	Date      time.Time `json:"slot_date" db:"slot_date"`
 	StartTime time.Time `json:"start_time" db:"start_time"`
 	EndTime   time.Time `json:"end_time" db:"end_time"`
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Team is a fixed line-up of players of a game. A side of a confirmed match made up of exactly the members
+// of a team counts towards the team's record and rating.
+type Team struct {
+	TeamID          uuid.UUID   `json:"team_id" db:"team_id"`
+	GameID          uuid.UUID   `json:"game_id" db:"game_id"`
+	Name            string      `json:"name" db:"name"`
+	CreatedBy       uuid.UUID   `json:"created_by" db:"created_by"`
+	Members         []uuid.UUID `json:"members" db:"-"`
+	Wins            int         `json:"wins" db:"wins"`
+	Losses          int         `json:"losses" db:"losses"`
+	Draws           int         `json:"draws" db:"draws"`
+	Rating          float64     `json:"rating" db:"rating"`
+	RatingDeviation float64     `json:"rating_deviation" db:"rating_deviation"`
+	Volatility      float64     `json:"volatility" db:"volatility"`
+	CreatedAt       time.Time   `json:"created_at" db:"created_at"`
+}
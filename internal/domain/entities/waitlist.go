@@ -0,0 +1,13 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type WaitlistEntry struct {
+	WaitlistID uuid.UUID `json:"waitlist_id" db:"waitlist_id"`
+	SlotID     uuid.UUID `json:"slot_id" db:"slot_id"`
+	UserID     uuid.UUID `json:"user_id" db:"user_id"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,68 @@
+package domain_errors
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/config"
+	"time"
+)
+
+var (
+	// ErrSlotFull is returned when a slot has already reached its maximum number of players
+	ErrSlotFull = errors.New("slot is already booked")
+	// ErrAlreadyBooked is returned when the user already holds a booking in the slot
+	ErrAlreadyBooked = errors.New("user is already booked in this slot")
+	// ErrGameRoomClosed is returned when booking a slot on a day the game room is closed
+	ErrGameRoomClosed = errors.New("the game room is closed on that day")
+	// ErrSlotNotFull is returned when joining the waitlist of a slot that still has free seats
+	ErrSlotNotFull = errors.New("slot still has free seats")
+	// ErrAlreadyWaitlisted is returned when the user is already in the waitlist of the slot
+	ErrAlreadyWaitlisted = errors.New("user is already on the waitlist for this slot")
+	// ErrInvalidCheckInCode is returned when no booking of the user with the code is open for check-in
+	ErrInvalidCheckInCode = errors.New("no booking with this check-in code is open for check-in")
+	// ErrResultAlreadyReported is returned when the match of the slot already has a reported result
+	ErrResultAlreadyReported = errors.New("a result has already been reported for this match")
+	// ErrNoResultToConfirm is returned when the user has no pending response to the result
+	ErrNoResultToConfirm = errors.New("this result is not awaiting your confirmation")
+	// ErrResultNotDisputed is returned when resolving a result that is not disputed
+	ErrResultNotDisputed = errors.New("result is not disputed")
+	// ErrResultAlreadyApplied is returned when the confirmed result has already been counted in the leaderboard
+	ErrResultAlreadyApplied = errors.New("result has already been applied")
+	// ErrNotAParticipant is returned when the user did not play in the match
+	ErrNotAParticipant = errors.New("user did not play in this match")
+	// ErrTeamNameTaken is returned when another team of the game already has the name
+	ErrTeamNameTaken = errors.New("a team with this name already exists for the game")
+	// ErrTeamAlreadyExists is returned when the same players already form a team of the game
+	ErrTeamAlreadyExists = errors.New("these players already form a team for the game")
+	// ErrNotATeamMember is returned when the user is not a member of the team
+	ErrNotATeamMember = errors.New("user is not a member of this team")
+)
+
+// BookingOverlapError is returned when a booking would overlap another booking of the same user
+type BookingOverlapError struct {
+	BookingID uuid.UUID
+	GameName  string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (e *BookingOverlapError) Error() string {
+	startTime, endTime := e.StartTime.In(config.Location()), e.EndTime.In(config.Location())
+	return fmt.Sprintf("booking overlaps your %s booking on %s from %s to %s", e.GameName,
+		startTime.Format("Mon, 02 Jan"), startTime.Format("03:04 PM"), endTime.Format("03:04 PM"))
+}
+
+// GroupMemberError is returned when one member of a group booking cannot be booked into the slot
+type GroupMemberError struct {
+	UserID uuid.UUID
+	Err    error
+}
+
+func (e *GroupMemberError) Error() string {
+	return fmt.Sprintf("user %s: %v", e.UserID, e.Err)
+}
+
+func (e *GroupMemberError) Unwrap() error {
+	return e.Err
+}
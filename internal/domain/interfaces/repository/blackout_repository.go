@@ -0,0 +1,16 @@
+package repository_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"time"
+)
+
+type BlackoutRepository interface {
+	CreateBlackout(ctx context.Context, blackout *entities.Blackout) (uuid.UUID, []models.CancelledBooking, error)
+	FetchUpcomingBlackouts(ctx context.Context) ([]models.Blackouts, error)
+	FetchBlackoutsBetween(ctx context.Context, from, to time.Time) ([]entities.Blackout, error)
+	DeleteBlackout(ctx context.Context, id uuid.UUID) error
+}
@@ -5,17 +5,31 @@ import (
 	"github.com/google/uuid"
 	"project2/internal/domain/entities"
 	"project2/internal/models"
+	"time"
 )
 
 type BookingRepository interface {
 	CreateBooking(ctx context.Context, booking *entities.Booking) (uuid.UUID, error)
+	CreateBookingWithinCapacity(ctx context.Context, booking *entities.Booking, maxPlayers int) (uuid.UUID, error)
+	CreateGroupBooking(ctx context.Context, slotID uuid.UUID, userIDs []uuid.UUID, maxPlayers int) ([]uuid.UUID, error)
 	FetchBookingByID(ctx context.Context, id uuid.UUID) (*entities.Booking, error)
 	DeleteBookingByID(ctx context.Context, id uuid.UUID) error
+	CancelBookingsBelowMinPlayers(ctx context.Context, slotID uuid.UUID, minPlayers int) ([]uuid.UUID, error)
+	CancelBookingAndPromote(ctx context.Context, bookingID uuid.UUID, maxPlayers int) (uuid.UUID, error)
+	AddToWaitlist(ctx context.Context, entry *entities.WaitlistEntry) error
+	RemoveFromWaitlist(ctx context.Context, slotID, userID uuid.UUID) error
+	FetchWaitlistPosition(ctx context.Context, slotID, userID uuid.UUID) (int, int, error)
 	FetchBookingsByUserID(ctx context.Context, userID uuid.UUID) ([]entities.Booking, error)
 	FetchBookingsBySlotID(ctx context.Context, slotID uuid.UUID) ([]entities.Booking, error)
 	FetchUpcomingBookingsByUserID(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error)
 	UpdateBookingResult(ctx context.Context, bookingId uuid.UUID, result string) error
 	FetchBookingsToUpdateResult(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error)
 	FetchSlotBookedUsers(ctx context.Context, slotId uuid.UUID) ([]string, error)
+	FetchBookingCountsBySlotIDs(ctx context.Context, slotIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	FetchBookingBySlotAndUserId(ctx context.Context, slotId uuid.UUID, userID uuid.UUID) (models.Bookings, error)
+	RescheduleBooking(ctx context.Context, bookingID, newSlotID uuid.UUID, maxPlayers int) (uuid.UUID, uuid.UUID, error)
+	TransferBooking(ctx context.Context, bookingID, toUserID uuid.UUID) error
+	CheckInBooking(ctx context.Context, userID uuid.UUID, code string, from, to time.Time) (uuid.UUID, error)
+	MarkNoShows(ctx context.Context, startedAfter, startedBefore time.Time) ([]models.NoShow, error)
+	FetchAttendanceStats(ctx context.Context, userID uuid.UUID) (models.AttendanceStats, error)
 }
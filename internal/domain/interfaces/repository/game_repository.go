@@ -12,4 +12,6 @@ type GameRepository interface {
 	CreateGame(ctx context.Context, game *entities.Game) (uuid.UUID, error)
 	DeleteGame(ctx context.Context, id uuid.UUID) error
 	UpdateGameStatus(ctx context.Context, gameID uuid.UUID, status bool) error
+	UpdateGameSchedule(ctx context.Context, game *entities.Game) error
+	UpdateScoringStrategy(ctx context.Context, gameID uuid.UUID, strategy string) error
 }
@@ -0,0 +1,15 @@
+package repository_interfaces
+
+import (
+	"context"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"time"
+)
+
+type HolidayRepository interface {
+	CreateHolidays(ctx context.Context, holidays []entities.Holiday) ([]models.HolidayBooking, error)
+	FetchUpcomingHolidays(ctx context.Context) ([]entities.Holiday, error)
+	FetchHolidaysBetween(ctx context.Context, from, to time.Time) ([]entities.Holiday, error)
+	DeleteHoliday(ctx context.Context, date time.Time) error
+}
@@ -8,8 +8,11 @@ import (
 )
 
 type LeaderboardRepository interface {
-	FetchGameLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.Leaderboard, error)
+	FetchGameLeaderboard(ctx context.Context, gameID uuid.UUID, provisionalGames int) ([]models.Leaderboard, error)
 	FetchUserGameStats(ctx context.Context, userID, gameID uuid.UUID) (*entities.Leaderboard, error)
 	FetchUserOverallStats(ctx context.Context, userID uuid.UUID) ([]entities.Leaderboard, error)
 	UpdateUserGameStats(ctx context.Context, leaderboard *entities.Leaderboard) error
+	FetchGameStats(ctx context.Context, gameID uuid.UUID) ([]*entities.Leaderboard, error)
+	FetchMatchHistory(ctx context.Context, gameID uuid.UUID) ([][]entities.MatchPlayer, error)
+	UpdateScores(ctx context.Context, leaderboard []*entities.Leaderboard) error
 }
@@ -0,0 +1,16 @@
+package repository_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"time"
+)
+
+type RecurringBookingRepository interface {
+	CreateRecurringBooking(ctx context.Context, recurrence *entities.RecurringBooking) (uuid.UUID, error)
+	FetchRecurringBookingsByUserID(ctx context.Context, userID uuid.UUID) ([]models.RecurringBookings, error)
+	FetchActiveRecurringBookingsByGameID(ctx context.Context, gameID uuid.UUID, date time.Time) ([]entities.RecurringBooking, error)
+	DeleteRecurringBooking(ctx context.Context, recurrenceID uuid.UUID, userID uuid.UUID) error
+}
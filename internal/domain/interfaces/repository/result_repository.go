@@ -0,0 +1,29 @@
+package repository_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"time"
+)
+
+// StandingsScorer derives the leaderboard entries a match changes from the current stats of every player of its game
+type StandingsScorer func(standings []*entities.Leaderboard) ([]*entities.Leaderboard, error)
+
+// TeamRater derives the teams a match changes from the current records and ratings of every team of its game
+type TeamRater func(teams []entities.Team) ([]*entities.Team, error)
+
+type ResultRepository interface {
+	CreateMatch(ctx context.Context, match *entities.Match) (uuid.UUID, error)
+	FetchMatchByID(ctx context.Context, matchID uuid.UUID) (*entities.Match, error)
+	FetchUnreportedMatches(ctx context.Context, userID uuid.UUID, endedBefore time.Time) ([]models.Bookings, error)
+	FetchMatchParticipants(ctx context.Context, slotID uuid.UUID) ([]models.MatchParticipant, error)
+	FetchResultsAwaitingConfirmation(ctx context.Context, userID uuid.UUID) ([]models.ResultReport, error)
+	FetchDisputedResults(ctx context.Context) ([]models.ResultReport, error)
+	RespondToResult(ctx context.Context, matchID, userID uuid.UUID, confirmed bool) (string, error)
+	ResolveDispute(ctx context.Context, matchID uuid.UUID, players []entities.MatchPlayer) error
+	ConfirmExpiredResults(ctx context.Context, deadline time.Time) (int, error)
+	FetchUnappliedMatchIDs(ctx context.Context) ([]uuid.UUID, error)
+	ApplyMatch(ctx context.Context, matchID, gameID uuid.UUID, scoreStandings StandingsScorer, rateTeams TeamRater) error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"project2/internal/domain/entities"
+	"project2/internal/models"
 	"time"
 )
 
@@ -16,4 +17,8 @@ type SlotRepository interface {
 	FetchSlotsByGameID(ctx context.Context, gameID uuid.UUID) ([]entities.Slot, error)
 	FetchSlotsByGameIDAndDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]entities.Slot, error)
 	UpdateSlotStatus(ctx context.Context, slotID uuid.UUID, isBooked bool) error
+	FetchSlotsStartingBetween(ctx context.Context, from, to time.Time) ([]entities.Slot, error)
+	FetchSlotOccupancyByGameIDAndDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error)
+	FetchSlotOccupancyByDate(ctx context.Context, date time.Time) ([]models.SlotOccupancy, error)
+	FetchFreeSlots(ctx context.Context, search models.SlotSearch) ([]models.FreeSlot, error)
 }
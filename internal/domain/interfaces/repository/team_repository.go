@@ -0,0 +1,16 @@
+package repository_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+)
+
+type TeamRepository interface {
+	CreateTeam(ctx context.Context, team *entities.Team) (uuid.UUID, error)
+	FetchGameTeams(ctx context.Context, gameID uuid.UUID) ([]entities.Team, error)
+	FetchUserTeams(ctx context.Context, userID uuid.UUID) ([]models.Team, error)
+	FetchTeamLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.TeamLeaderboard, error)
+	DeleteTeam(ctx context.Context, teamID, userID uuid.UUID) error
+}
@@ -0,0 +1,14 @@
+package service_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+)
+
+type BlackoutService interface {
+	CreateBlackout(ctx context.Context, blackout *entities.Blackout) (int, error)
+	GetUpcomingBlackouts(ctx context.Context) ([]models.Blackouts, error)
+	DeleteBlackout(ctx context.Context, id uuid.UUID) error
+}
@@ -8,9 +8,19 @@ import (
 
 type BookingService interface {
 	MakeBooking(ctx context.Context, userID uuid.UUID, slotID uuid.UUID) error
+	MakeGroupBooking(ctx context.Context, organiserID uuid.UUID, slotID uuid.UUID, members []string) error
+	CancelBooking(ctx context.Context, userID uuid.UUID, bookingID uuid.UUID) error
+	JoinWaitlist(ctx context.Context, userID uuid.UUID, slotID uuid.UUID) (int, error)
+	LeaveWaitlist(ctx context.Context, userID uuid.UUID, slotID uuid.UUID) error
+	GetWaitlistPosition(ctx context.Context, userID uuid.UUID, slotID uuid.UUID) (int, int, error)
 	GetUpcomingBookings(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error)
 	GetBookingsToUpdateResult(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error)
 	UpdateBookingResult(ctx context.Context, bookingId uuid.UUID, result string) error
 	GetSlotBookedUsers(ctx context.Context, slotId uuid.UUID) ([]string, error)
+	GetSlotBookingCounts(ctx context.Context, slotIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	GetBookingByUserAndSlotID(ctx context.Context, userID uuid.UUID, slotID uuid.UUID) (models.Bookings, error)
+	RescheduleBooking(ctx context.Context, userID uuid.UUID, bookingID uuid.UUID, newSlotID uuid.UUID) error
+	TransferBooking(ctx context.Context, userID uuid.UUID, bookingID uuid.UUID, recipient string) error
+	CheckIn(ctx context.Context, userID uuid.UUID, code string) error
+	GetAttendanceStats(ctx context.Context, userID uuid.UUID) (models.AttendanceStats, error)
 }
@@ -12,4 +12,6 @@ type GameService interface {
 	CreateGame(ctx context.Context, game *entities.Game) (uuid.UUID, error)
 	DeleteGame(ctx context.Context, id uuid.UUID) error
 	UpdateGameStatus(ctx context.Context, id uuid.UUID, isActive bool) error
+	UpdateGameSchedule(ctx context.Context, game *entities.Game) error
+	UpdateScoringStrategy(ctx context.Context, id uuid.UUID, strategy string) error
 }
@@ -0,0 +1,15 @@
+package service_interfaces
+
+import (
+	"context"
+	"project2/internal/domain/entities"
+	"time"
+)
+
+type HolidayService interface {
+	ImportHolidays(ctx context.Context, path string) (int, error)
+	AddHoliday(ctx context.Context, holiday *entities.Holiday) error
+	GetUpcomingHolidays(ctx context.Context) ([]entities.Holiday, error)
+	DeleteHoliday(ctx context.Context, date time.Time) error
+	GetClosure(ctx context.Context, date time.Time) (string, bool, error)
+}
@@ -3,11 +3,12 @@ package service_interfaces
 import (
 	"context"
 	"github.com/google/uuid"
+	"project2/internal/domain/entities"
 	"project2/internal/models"
 )
 
 type LeaderboardService interface {
 	GetGameLeaderboard(ctx context.Context, gameId uuid.UUID) ([]models.Leaderboard, error)
-	AddWinToUser(ctx context.Context, userId uuid.UUID, gameId uuid.UUID, bookingId uuid.UUID) error
-	AddLossToUser(ctx context.Context, userId uuid.UUID, gameId uuid.UUID, bookingId uuid.UUID) error
+	ScoreMatch(ctx context.Context, gameId uuid.UUID, standings []*entities.Leaderboard, players []entities.MatchPlayer) ([]*entities.Leaderboard, error)
+	ChangeScoringStrategy(ctx context.Context, gameId uuid.UUID, strategy string) error
 }
@@ -8,4 +8,5 @@ import (
 
 type NotificationService interface {
 	GetUserNotifications(ctx context.Context, userId uuid.UUID) ([]entities.Notification, error)
+	NotifyUser(ctx context.Context, userId uuid.UUID, message string) error
 }
@@ -0,0 +1,15 @@
+package service_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+)
+
+type RecurringBookingService interface {
+	CreateRecurringBooking(ctx context.Context, recurrence *entities.RecurringBooking) ([]models.BookingConflict, error)
+	GetUserRecurringBookings(ctx context.Context, userID uuid.UUID) ([]models.RecurringBookings, error)
+	DeleteRecurringBooking(ctx context.Context, userID uuid.UUID, recurrenceID uuid.UUID) error
+	MaterialiseRecurringBookings(ctx context.Context, slots []entities.Slot) error
+}
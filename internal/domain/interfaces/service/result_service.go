@@ -0,0 +1,19 @@
+package service_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+)
+
+type ResultService interface {
+	GetUnreportedMatches(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error)
+	GetMatchParticipants(ctx context.Context, slotID uuid.UUID) ([]models.MatchParticipant, error)
+	ReportResult(ctx context.Context, reporterID, slotID uuid.UUID, players []entities.MatchPlayer) (uuid.UUID, error)
+	GetResultsAwaitingConfirmation(ctx context.Context, userID uuid.UUID) ([]models.ResultReport, error)
+	RespondToResult(ctx context.Context, userID, matchID uuid.UUID, confirmed bool) error
+	GetDisputedResults(ctx context.Context) ([]models.ResultReport, error)
+	ResolveDispute(ctx context.Context, matchID uuid.UUID, players []entities.MatchPlayer) error
+	ConfirmExpiredResults(ctx context.Context) (int, error)
+}
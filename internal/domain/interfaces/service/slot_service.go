@@ -4,10 +4,18 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"time"
 )
 
 type SlotService interface {
 	GetCurrentDayGameSlots(ctx context.Context, gameID uuid.UUID) ([]entities.Slot, error)
+	GetGameSlotsByDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]entities.Slot, error)
+	GetGameSlotOccupancy(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error)
+	GetJoinableGameSlots(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error)
+	GetSlotOccupancyByDate(ctx context.Context, date time.Time) ([]models.SlotOccupancy, error)
 	GetSlotByID(ctx context.Context, slotID uuid.UUID) (*entities.Slot, error)
 	MarkSlotAsBooked(ctx context.Context, slotID uuid.UUID) error
+	MarkSlotAsAvailable(ctx context.Context, slotID uuid.UUID) error
+	FindFreeSlots(ctx context.Context, search models.SlotSearch) ([]models.FreeSlot, error)
 }
@@ -0,0 +1,16 @@
+package service_interfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+)
+
+type TeamService interface {
+	CreateTeam(ctx context.Context, creatorID, gameID uuid.UUID, name string, members []string) (uuid.UUID, error)
+	GetUserTeams(ctx context.Context, userID uuid.UUID) ([]models.Team, error)
+	GetTeamLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.TeamLeaderboard, error)
+	DisbandTeam(ctx context.Context, userID, teamID uuid.UUID) error
+	RateMatch(ctx context.Context, teams []entities.Team, players []entities.MatchPlayer) ([]*entities.Team, error)
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/google/uuid"
+	"project2/internal/domain/entities"
 	"time"
 )
 
@@ -18,14 +19,173 @@ type Invitations struct {
 
 type Bookings struct {
 	BookingId   uuid.UUID
+	SlotId      uuid.UUID
 	GameName    string
+	Instance    int
 	Date        time.Time
 	StartTime   time.Time
 	EndTime     time.Time
+	CheckInCode string
 	BookedUsers []string
 }
 
 type Leaderboard struct {
-	UserName string
-	Score    float64
+	UserName        string
+	Score           float64
+	Rating          float64
+	RatingDeviation float64
+	GamesPlayed     int
+	// Provisional is set until the player has played config.ProvisionalGames games of the game
+	Provisional bool
+}
+
+type RecurringBookings struct {
+	RecurrenceId uuid.UUID
+	GameName     string
+	Weekdays     []time.Weekday
+	StartTime    string
+	EndDate      time.Time
+}
+
+// BookingConflict describes an occurrence of a recurring booking that could not be booked
+type BookingConflict struct {
+	GameName  string
+	StartTime time.Time
+	Reason    string
+}
+
+type Blackouts struct {
+	BlackoutId uuid.UUID
+	GameName   string
+	StartTime  time.Time
+	EndTime    time.Time
+	Reason     string
+}
+
+// CancelledBooking identifies a booking that was removed because its slot was closed
+type CancelledBooking struct {
+	UserId    uuid.UUID
+	GameName  string
+	StartTime time.Time
+}
+
+// HolidayBooking identifies a booking on a date that was declared a holiday after the booking was made
+type HolidayBooking struct {
+	UserId      uuid.UUID
+	GameName    string
+	StartTime   time.Time
+	HolidayName string
+}
+
+// NoShow identifies a booking whose player did not check in
+type NoShow struct {
+	UserId    uuid.UUID
+	GameName  string
+	StartTime time.Time
+}
+
+// SlotSearch holds the filters of a free slot search. Empty filters match every slot.
+type SlotSearch struct {
+	GameIDs       []uuid.UUID
+	EarliestStart time.Time
+	LatestStart   time.Time
+	SeatsNeeded   int
+	Limit         int
+}
+
+// FreeSlot is a slot found by a free slot search, together with its number of free seats
+type FreeSlot struct {
+	SlotId    uuid.UUID
+	GameId    uuid.UUID
+	GameName  string
+	Instance  int
+	StartTime time.Time
+	EndTime   time.Time
+	FreeSeats int
+}
+
+// AvailabilityGrid is the time × game matrix of the free seats of a day.
+// Each row holds one cell per game, in the order of Games.
+type AvailabilityGrid struct {
+	Games []string
+	Rows  []AvailabilityRow
+}
+
+// AvailabilityRow holds the cells of the slots starting at the same time
+type AvailabilityRow struct {
+	StartTime time.Time
+	Cells     []string
+}
+
+// SlotOccupancy is a slot together with the number of players booked into it and its capacity
+type SlotOccupancy struct {
+	entities.Slot
+	BookedCount int
+	Capacity    int
+}
+
+// IsFull reports whether no seat is left in the slot
+func (o SlotOccupancy) IsFull() bool {
+	return o.IsBooked || o.BookedCount >= o.Capacity
+}
+
+// IsWaitingForOpponents reports whether some players are booked into the slot and seats are still free
+func (o SlotOccupancy) IsWaitingForOpponents() bool {
+	return o.BookedCount > 0 && !o.IsFull()
+}
+
+// AttendanceStats counts how often a user turned up for their bookings
+type AttendanceStats struct {
+	CheckIns int
+	NoShows  int
+}
+
+// ResultReport is a reported match as shown to the players asked to confirm it and to the admins resolving disputes
+type ResultReport struct {
+	MatchId    uuid.UUID
+	SlotId     uuid.UUID
+	GameName   string
+	StartTime  time.Time
+	ReportedBy string
+	Players    []MatchPlayerResult
+	ConfirmBy  time.Time
+	DisputedBy []string
+}
+
+// MatchPlayerResult is the outcome of one player of a match, ordered by side
+type MatchPlayerResult struct {
+	Username string
+	Side     int
+	Outcome  string
+	Score    *int
+}
+
+// Team is a team of the user as listed in the teams menu
+type Team struct {
+	TeamId   uuid.UUID
+	Name     string
+	GameName string
+	Members  []string
+	Wins     int
+	Draws    int
+	Losses   int
+	Rating   float64
+}
+
+// TeamLeaderboard is one row of the team leaderboard of a game
+type TeamLeaderboard struct {
+	TeamName        string
+	Members         []string
+	Wins            int
+	Draws           int
+	Losses          int
+	Rating          float64
+	RatingDeviation float64
+}
+
+// MatchParticipant is a player booked into the match of a slot
+type MatchParticipant struct {
+	UserId    uuid.UUID
+	BookingId uuid.UUID
+	Username  string
 }
@@ -3,8 +3,14 @@ package ui
 import (
 	"context"
 	"fmt"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	"project2/pkg/scoring"
+	"project2/pkg/utils"
+	"project2/pkg/validation"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func (ui *UI) ShowAdminDashboard() {
@@ -18,7 +24,12 @@ func (ui *UI) ShowAdminDashboard() {
 		fmt.Println("1. 🆕 Create a Game")
 		fmt.Println("2. 🗑️ Delete a Game")
 		fmt.Println("3. 📊 View User Stats")
-		fmt.Println("4. 🚪 Logout")
+		fmt.Println("4. 🕒 Edit Game Schedule")
+		fmt.Println("5. 🚧 Manage Blackouts")
+		fmt.Println("6. 📅 Manage Holidays")
+		fmt.Println("7. ⚖️ Resolve Disputed Results")
+		fmt.Println("8. 🏅 Change Scoring Strategy")
+		fmt.Println("9. 🚪 Logout")
 
 		fmt.Print("\nEnter your choice: ")
 
@@ -33,11 +44,21 @@ func (ui *UI) ShowAdminDashboard() {
 		case "3":
 			ui.ViewUserStats()
 		case "4":
+			ui.EditGameSchedule()
+		case "5":
+			ui.ManageBlackouts()
+		case "6":
+			ui.ManageHolidays()
+		case "7":
+			ui.ResolveDisputes()
+		case "8":
+			ui.ChangeScoringStrategy()
+		case "9":
 			fmt.Println("\nLogging out... 👋")
 			return
 		default:
 			fmt.Println("\033[1;31m") // Red bold
-			fmt.Println("❌ Invalid choice. Please enter a number between 1 and 4.")
+			fmt.Println("❌ Invalid choice. Please enter a number between 1 and 9.")
 			fmt.Println("\033[0m") // Reset color
 		}
 	}
@@ -112,11 +133,24 @@ func (ui *UI) CreateGame() {
 		}
 	}
 
+	// Get the operating hours and slot length
+	fmt.Println("Press Enter to keep the default value shown in brackets.")
+	schedule := ui.readGameSchedule(entities.GameSchedule{
+		OpenTime:     config.DefaultOpenTime,
+		CloseTime:    config.DefaultCloseTime,
+		SlotDuration: config.DefaultSlotDuration,
+	})
+	strategy := ui.readScoringStrategy(config.DefaultScoringStrategy)
+
 	newGame := &entities.Game{
-		GameName:   gameName,
-		MaxPlayers: maxPlayers,
-		MinPlayers: minPlayers,
-		Instances:  instances,
+		GameName:        gameName,
+		MaxPlayers:      maxPlayers,
+		MinPlayers:      minPlayers,
+		Instances:       instances,
+		OpenTime:        schedule.OpenTime,
+		CloseTime:       schedule.CloseTime,
+		SlotDuration:    schedule.SlotDuration,
+		ScoringStrategy: strategy,
 	}
 	_, err := ui.gameService.CreateGame(context.Background(), newGame)
 	if err != nil {
@@ -124,6 +158,9 @@ func (ui *UI) CreateGame() {
 		return
 	}
 
+	// Generate the slots of the new game right away instead of waiting for the daily run
+	ui.slotGenerator.Trigger()
+
 	fmt.Println("\033[1;32m") // Green bold
 	fmt.Println("✅ Game created successfully!")
 	fmt.Println("\033[0m") // Reset color
@@ -180,6 +217,226 @@ func (ui *UI) DeleteGame() {
 	fmt.Println("\033[0m") // Reset color
 }
 
+func (ui *UI) EditGameSchedule() {
+	fmt.Println("\033[1;34m") // Blue bold
+	fmt.Println("\n🕒 Edit Game Schedule")
+	fmt.Println("\033[0m") // Reset color
+
+	game, ok := ui.selectGame()
+	if !ok {
+		return
+	}
+
+	// Work on a copy so that nothing changes unless the admin saves
+	weekdaySchedules := make(map[time.Weekday]entities.GameSchedule)
+	for weekday, schedule := range game.WeekdaySchedules {
+		weekdaySchedules[weekday] = schedule
+	}
+	game.WeekdaySchedules = weekdaySchedules
+
+	for {
+		fmt.Printf("\n\033[1;34mSchedule of %s\033[0m\n", game.GameName)
+		fmt.Printf("Default: %s - %s, %d minute slots\n", game.OpenTime, game.CloseTime, game.SlotDuration)
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			if schedule, ok := game.WeekdaySchedules[weekday]; ok {
+				fmt.Printf("%-9s  %s - %s, %d minute slots\n", weekday.String()+":", schedule.OpenTime, schedule.CloseTime, schedule.SlotDuration)
+			}
+		}
+
+		fmt.Println("\n1. ✏️ Change default hours")
+		fmt.Println("2. 📅 Set a weekday override")
+		fmt.Println("3. 🗑️ Remove a weekday override")
+		fmt.Println("4. 💾 Save and go back")
+		fmt.Println("5. 🔙 Discard changes")
+		fmt.Print("\nEnter your choice: ")
+
+		input, _ := ui.reader.ReadString('\n')
+		switch strings.TrimSpace(input) {
+		case "1":
+			fmt.Println("Press Enter to keep the current value shown in brackets.")
+			schedule := ui.readGameSchedule(entities.GameSchedule{OpenTime: game.OpenTime, CloseTime: game.CloseTime, SlotDuration: game.SlotDuration})
+			game.OpenTime, game.CloseTime, game.SlotDuration = schedule.OpenTime, schedule.CloseTime, schedule.SlotDuration
+		case "2":
+			weekday, ok := ui.readWeekday()
+			if !ok {
+				continue
+			}
+			current, exists := game.WeekdaySchedules[weekday]
+			if !exists {
+				current = entities.GameSchedule{OpenTime: game.OpenTime, CloseTime: game.CloseTime, SlotDuration: game.SlotDuration}
+			}
+			fmt.Println("Press Enter to keep the value shown in brackets.")
+			game.WeekdaySchedules[weekday] = ui.readGameSchedule(current)
+		case "3":
+			weekday, ok := ui.readWeekday()
+			if !ok {
+				continue
+			}
+			delete(game.WeekdaySchedules, weekday)
+		case "4":
+			err := ui.gameService.UpdateGameSchedule(context.Background(), game)
+			if err != nil {
+				fmt.Printf("\033[1;31m❌ Error updating schedule: %v\033[0m\n", err)
+				continue
+			}
+			ui.slotGenerator.Trigger()
+			fmt.Println("\033[1;32m✅ Schedule updated! The upcoming days without bookings will follow it shortly.\033[0m")
+			return
+		case "5":
+			return
+		default:
+			fmt.Println("\033[1;31m❌ Invalid choice. Please enter a number between 1 and 5.\033[0m")
+		}
+	}
+}
+
+func (ui *UI) ChangeScoringStrategy() {
+	fmt.Println("\033[1;34m") // Blue bold
+	fmt.Println("\n🏅 Change Scoring Strategy")
+	fmt.Println("\033[0m") // Reset color
+
+	game, ok := ui.selectGame()
+	if !ok {
+		return
+	}
+
+	fmt.Printf("%s is scored by %s.\n", game.GameName, scoringStrategyLabels[game.ScoringStrategy])
+	fmt.Println("Press Enter to keep it and only recompute the leaderboard.")
+	strategy := ui.readScoringStrategy(game.ScoringStrategy)
+
+	err := ui.leaderboardService.ChangeScoringStrategy(context.Background(), game.GameID, strategy)
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error changing scoring strategy: %v\033[0m\n", err)
+		return
+	}
+	fmt.Printf("\033[1;32m✅ %s is now scored by %s and its leaderboard has been recomputed.\033[0m\n", game.GameName, scoringStrategyLabels[strategy])
+}
+
+// scoringStrategyLabels describes the scoring strategies to admins
+var scoringStrategyLabels = map[string]string{
+	scoring.StrategyWinRatio: "win ratio (legacy)",
+	scoring.StrategyRating:   fmt.Sprintf("rating (%s)", config.RatingSystem),
+	scoring.StrategyPoints:   fmt.Sprintf("points (%g per win, %g per draw, %g per loss)", config.PointsPerWin, config.PointsPerDraw, config.PointsPerLoss),
+	scoring.StrategyLadder:   "ladder position",
+}
+
+// readScoringStrategy lets the admin pick a scoring strategy, keeping the current one on empty input
+func (ui *UI) readScoringStrategy(current string) string {
+	fmt.Println("Scoring strategies:")
+	for i, strategy := range scoring.Strategies {
+		fmt.Printf("%d. %s\n", i+1, scoringStrategyLabels[strategy])
+	}
+
+	for {
+		fmt.Printf("Choose the scoring strategy [%s]: ", scoringStrategyLabels[current])
+		input, _ := ui.reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if input == "" {
+			return current
+		}
+		choice, err := strconv.Atoi(input)
+		if err == nil && choice >= 1 && choice <= len(scoring.Strategies) {
+			return scoring.Strategies[choice-1]
+		}
+		fmt.Println("\033[1;31m❌ Invalid choice. Please enter a valid number.\033[0m")
+	}
+}
+
+// selectGame lists all games and returns the one chosen by the admin, or false to go back
+func (ui *UI) selectGame() (*entities.Game, bool) {
+	games, err := ui.gameService.GetAllGames(context.Background())
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error retrieving games: %v\033[0m\n", err)
+		return nil, false
+	}
+	if len(games) == 0 {
+		fmt.Println("\033[1;33m⚠️ No games available.\033[0m")
+		return nil, false
+	}
+
+	fmt.Println("\033[1;34mAvailable games:\033[0m")
+	for i, game := range games {
+		fmt.Printf("%d. %s\n", i+1, game.GameName)
+	}
+
+	for {
+		fmt.Print("Enter the number corresponding to the game (0 to go back): ")
+		input, _ := ui.reader.ReadString('\n')
+		choice, err := strconv.Atoi(strings.TrimSpace(input))
+		if err == nil && choice == 0 {
+			return nil, false
+		}
+		if err != nil || choice < 1 || choice > len(games) {
+			fmt.Println("\033[1;31m❌ Invalid choice. Please enter a valid number.\033[0m")
+			continue
+		}
+		return &games[choice-1], true
+	}
+}
+
+// readGameSchedule prompts for opening hours and slot length, keeping the current values on empty input
+func (ui *UI) readGameSchedule(current entities.GameSchedule) entities.GameSchedule {
+	schedule := current
+
+	for {
+		fmt.Printf("Enter the opening time HH:MM [%s]: ", current.OpenTime)
+		input, _ := ui.reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if input == "" {
+			break
+		}
+		if validation.IsValidTimeOfDay(input) {
+			schedule.OpenTime = input
+			break
+		}
+		fmt.Println("\033[1;31m❌ Invalid time. Please use the 24-hour HH:MM format.\033[0m")
+	}
+
+	for {
+		fmt.Printf("Enter the closing time HH:MM [%s]: ", current.CloseTime)
+		input, _ := ui.reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if input == "" {
+			break
+		}
+		if validation.IsValidTimeOfDay(input) {
+			schedule.CloseTime = input
+			break
+		}
+		fmt.Println("\033[1;31m❌ Invalid time. Please use the 24-hour HH:MM format.\033[0m")
+	}
+
+	for {
+		fmt.Printf("Enter the slot duration in minutes [%d]: ", current.SlotDuration)
+		input, _ := ui.reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if input == "" {
+			break
+		}
+		duration, err := strconv.Atoi(input)
+		if err == nil && duration > 0 {
+			schedule.SlotDuration = duration
+			break
+		}
+		fmt.Println("\033[1;31m❌ Invalid duration. Please enter a positive integer.\033[0m")
+	}
+
+	return schedule
+}
+
+// readWeekday prompts for a day of the week such as "Mon" or "monday"
+func (ui *UI) readWeekday() (time.Weekday, bool) {
+	fmt.Print("Enter the weekday (e.g. Mon, Tue): ")
+	input, _ := ui.reader.ReadString('\n')
+
+	weekday, err := utils.ParseWeekday(input)
+	if err != nil {
+		fmt.Println("\033[1;31m❌ Invalid weekday.\033[0m")
+		return time.Sunday, false
+	}
+	return weekday, true
+}
+
 func (ui *UI) ViewUserStats() {
 	fmt.Println("\033[1;34m") // Blue bold
 	fmt.Println("\n📊 Viewing User Stats...")
@@ -213,4 +470,12 @@ func (ui *UI) ViewUserStats() {
 	fmt.Printf("👤 Gender: %v\n", user.Gender)
 	fmt.Printf("📞 Phone Number: %v\n", user.MobileNumber)
 	fmt.Printf("🎖️ Role: %s\n", user.Role)
+
+	stats, err := ui.bookingService.GetAttendanceStats(context.Background(), user.UserID)
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error retrieving attendance: %v\033[0m\n", err)
+		return
+	}
+	fmt.Printf("✅ Check-ins: %d\n", stats.CheckIns)
+	fmt.Printf("🚫 No-shows: %d\n", stats.NoShows)
 }
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"project2/pkg/utils"
+)
+
+// ViewAvailabilityGrid shows the free seats of every game at every time of the chosen day in a single table.
+func (ui *UI) ViewAvailabilityGrid() {
+	date, ok := ui.selectBookingDate()
+	if !ok {
+		return
+	}
+
+	games, err := ui.gameService.GetAllGames(context.Background())
+	if err != nil {
+		fmt.Println("❌ Error fetching games:", err)
+		return
+	}
+	slots, err := ui.slotService.GetSlotOccupancyByDate(context.Background(), date)
+	if err != nil {
+		fmt.Println("❌ Error fetching slots:", err)
+		return
+	}
+	if len(slots) == 0 {
+		reason, closed, err := ui.holidayService.GetClosure(context.Background(), date)
+		if err == nil && closed {
+			fmt.Printf("🚪 Closed on %s. %s.\n", utils.FormatDay(date), reason)
+			return
+		}
+		fmt.Printf("⚠️ No slots on %s.\n", utils.FormatDay(date))
+		return
+	}
+
+	grid := utils.BuildAvailabilityGrid(games, slots, utils.Now())
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(false)
+	table.SetHeader(append([]string{"Time"}, grid.Games...))
+	for _, row := range grid.Rows {
+		line := []string{utils.FormatClock(row.StartTime)}
+		for _, cell := range row.Cells {
+			switch cell {
+			case "FULL":
+				line = append(line, color.New(color.FgRed).Sprint(cell))
+			case "PAST", "-":
+				line = append(line, color.New(color.FgHiBlack).Sprint(cell))
+			default:
+				line = append(line, color.New(color.FgGreen).Sprint(cell))
+			}
+		}
+		table.Append(line)
+	}
+
+	fmt.Printf("🗓️ Free seats on %s:\n", utils.FormatDay(date))
+	table.Render()
+}
@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"project2/pkg/validation"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (ui *UI) ManageBlackouts() {
+	for {
+		fmt.Println("\033[1;34m") // Blue bold
+		fmt.Println("\n🚧 Blackout Windows")
+		fmt.Println("\033[0m") // Reset color
+
+		blackouts, err := ui.blackoutService.GetUpcomingBlackouts(context.Background())
+		if err != nil {
+			fmt.Printf("\033[1;31m❌ Error retrieving blackouts: %v\033[0m\n", err)
+			return
+		}
+
+		if len(blackouts) == 0 {
+			fmt.Println("No upcoming blackouts.")
+		}
+		for i, blackout := range blackouts {
+			fmt.Printf("%d. %s: %s - %s", i+1, blackout.GameName, blackout.StartTime.In(config.Location()).Format("Mon, 02 Jan 03:04 PM"), blackout.EndTime.In(config.Location()).Format("Mon, 02 Jan 03:04 PM"))
+			if blackout.Reason != "" {
+				fmt.Printf(" (%s)", blackout.Reason)
+			}
+			fmt.Println()
+		}
+
+		fmt.Println("\n1. ➕ Add a blackout")
+		fmt.Println("2. 🗑️ Remove a blackout")
+		fmt.Println("3. 🔙 Go back")
+		fmt.Print("\nEnter your choice: ")
+
+		input, _ := ui.reader.ReadString('\n')
+		switch strings.TrimSpace(input) {
+		case "1":
+			ui.AddBlackout()
+		case "2":
+			ui.RemoveBlackout(blackouts)
+		case "3":
+			return
+		default:
+			fmt.Println("\033[1;31m❌ Invalid choice. Please enter a number between 1 and 3.\033[0m")
+		}
+	}
+}
+
+// AddBlackout asks for the games, dates and hours to close and creates the blackout
+func (ui *UI) AddBlackout() {
+	location := config.Location()
+
+	blackout := &entities.Blackout{}
+
+	fmt.Print("Close all games? (y/n): ")
+	input, _ := ui.reader.ReadString('\n')
+	if strings.ToLower(strings.TrimSpace(input)) != "y" {
+		game, ok := ui.selectGame()
+		if !ok {
+			return
+		}
+		blackout.GameID = &game.GameID
+	}
+
+	startDate, ok := ui.readDate("Enter the first closed date (YYYY-MM-DD): ", time.Time{}, location)
+	if !ok {
+		return
+	}
+	endDate, ok := ui.readDate("Enter the last closed date (YYYY-MM-DD) [same day]: ", startDate, location)
+	if !ok {
+		return
+	}
+
+	fromTime, ok := ui.readTimeOfDay("Enter the closing time HH:MM [whole day]: ", "00:00")
+	if !ok {
+		return
+	}
+	untilTime, ok := ui.readTimeOfDay("Enter the reopening time HH:MM [end of day]: ", "")
+	if !ok {
+		return
+	}
+
+	blackout.StartTime = atTimeOfDay(startDate, fromTime)
+	if untilTime == "" {
+		blackout.EndTime = endDate.AddDate(0, 0, 1)
+	} else {
+		blackout.EndTime = atTimeOfDay(endDate, untilTime)
+	}
+
+	fmt.Print("Enter the reason (e.g. Cleaning, All-hands): ")
+	blackout.Reason, _ = ui.reader.ReadString('\n')
+
+	cancelled, err := ui.blackoutService.CreateBlackout(context.Background(), blackout)
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error creating blackout: %v\033[0m\n", err)
+		return
+	}
+
+	fmt.Printf("\033[1;32m✅ Blackout created! %d booking(s) were cancelled and the players notified.\033[0m\n", cancelled)
+}
+
+// RemoveBlackout deletes one of the listed blackouts and asks for the slots of the reopened window to be generated
+func (ui *UI) RemoveBlackout(blackouts []models.Blackouts) {
+	if len(blackouts) == 0 {
+		return
+	}
+
+	fmt.Print("Enter the number of the blackout you want to remove (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	choice, err := strconv.Atoi(strings.TrimSpace(input))
+	if err == nil && choice == 0 {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(blackouts) {
+		fmt.Println("\033[1;31m❌ Invalid choice. Please enter a valid number.\033[0m")
+		return
+	}
+
+	if err := ui.blackoutService.DeleteBlackout(context.Background(), blackouts[choice-1].BlackoutId); err != nil {
+		fmt.Printf("\033[1;31m❌ Error removing blackout: %v\033[0m\n", err)
+		return
+	}
+	ui.slotGenerator.Trigger()
+	fmt.Println("\033[1;32m✅ Blackout removed! The slots of the window will be available again shortly.\033[0m")
+}
+
+// readDate prompts for a date in the given location. Empty input returns the default unless it is zero.
+func (ui *UI) readDate(prompt string, defaultDate time.Time, location *time.Location) (time.Time, bool) {
+	fmt.Print(prompt)
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	if input == "" && !defaultDate.IsZero() {
+		return defaultDate, true
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", input, location)
+	if err != nil {
+		fmt.Println("\033[1;31m❌ Invalid date. Please use the YYYY-MM-DD format.\033[0m")
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// readTimeOfDay prompts for a time of day, returning the default on empty input
+func (ui *UI) readTimeOfDay(prompt, defaultValue string) (string, bool) {
+	fmt.Print(prompt)
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return defaultValue, true
+	}
+	if !validation.IsValidTimeOfDay(input) {
+		fmt.Println("\033[1;31m❌ Invalid time. Please use the 24-hour HH:MM format.\033[0m")
+		return "", false
+	}
+	return input, true
+}
+
+// atTimeOfDay returns the given HH:MM time on the date, in the date's location
+func atTimeOfDay(date time.Time, value string) time.Time {
+	clock, _ := time.Parse("15:04", value)
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, date.Location())
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"project2/pkg/utils"
+	"strconv"
+	"strings"
+)
+
+// ResolveDisputes lists the disputed match results and settles the selected one with the outcome decided by the admin
+func (ui *UI) ResolveDisputes() {
+	fmt.Println("\033[1;34m") // Blue bold
+	fmt.Println("\n⚖️ Disputed Results")
+	fmt.Println("\033[0m") // Reset color
+
+	disputes, err := ui.resultService.GetDisputedResults(context.Background())
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error retrieving disputed results: %v\033[0m\n", err)
+		return
+	}
+
+	if len(disputes) == 0 {
+		fmt.Println("No disputed results.")
+		return
+	}
+	for i, dispute := range disputes {
+		fmt.Printf("%d. %s on %s at %s: %s reported \"%s\", disputed by %s\n", i+1, dispute.GameName,
+			utils.FormatDay(dispute.StartTime), utils.FormatClock(dispute.StartTime), dispute.ReportedBy,
+			utils.FormatMatchResult(dispute.Players), strings.Join(dispute.DisputedBy, ", "))
+	}
+
+	fmt.Print("\nEnter the number of the dispute you want to resolve (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	choice, err := strconv.Atoi(strings.TrimSpace(input))
+	if err == nil && choice == 0 {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(disputes) {
+		fmt.Println("\033[1;31m❌ Invalid choice. Please enter a valid number.\033[0m")
+		return
+	}
+	dispute := disputes[choice-1]
+
+	participants, err := ui.resultService.GetMatchParticipants(context.Background(), dispute.SlotId)
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error retrieving players: %v\033[0m\n", err)
+		return
+	}
+	players, ok := ui.readMatchOutcome(participants)
+	if !ok {
+		return
+	}
+
+	if err := ui.resultService.ResolveDispute(context.Background(), dispute.MatchId, players); err != nil {
+		fmt.Printf("\033[1;31m❌ Error resolving dispute: %v\033[0m\n", err)
+		return
+	}
+	fmt.Println("\033[1;32m✅ Dispute resolved! The leaderboard has been updated and the players notified.\033[0m")
+}
@@ -2,12 +2,17 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"os"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"project2/internal/models"
 	"project2/pkg/globals"
+	"project2/pkg/utils"
 	"strconv"
 	"strings"
 	"time"
@@ -67,36 +72,79 @@ func (ui *UI) ShowGameRoom() {
 func (ui *UI) HandleSelectedGame(game *entities.Game) {
 	fmt.Printf("✔️ You selected: %s\n", game.GameName)
 
-	// Retrieve all slots for the selected game
-	slots, err := ui.slotService.GetCurrentDayGameSlots(context.Background(), game.GameID)
+	// Let the user pick the day to play on
+	date, ok := ui.selectBookingDate()
+	if !ok {
+		return
+	}
+
+	// Let the user narrow the list down to the slots that already have players
+	fmt.Print("👥 Show only slots with players waiting for opponents? (y/n): ")
+	filter, _ := ui.reader.ReadString('\n')
+	joinableOnly := strings.ToLower(strings.TrimSpace(filter)) == "y"
+
+	// Retrieve the slots of the selected game on the chosen day, with the occupancy of every table
+	var slots []models.SlotOccupancy
+	var err error
+	if joinableOnly {
+		slots, err = ui.slotService.GetJoinableGameSlots(context.Background(), game.GameID, date)
+	} else {
+		slots, err = ui.slotService.GetGameSlotOccupancy(context.Background(), game.GameID, date)
+	}
 	if err != nil {
 		fmt.Println("❌ Error fetching slots:", err)
 		return
 	}
 
+	// A day may be declared a holiday after some of its slots were booked, so the closure is shown either way
+	if !joinableOnly {
+		reason, closed, err := ui.holidayService.GetClosure(context.Background(), date)
+		if err == nil && closed {
+			day := "on " + utils.FormatDay(date)
+			if utils.IsSameDay(date, utils.Now()) {
+				day = "today"
+			}
+			fmt.Printf("🚪 Closed %s. %s.\n", day, reason)
+			if len(slots) == 0 {
+				return
+			}
+			fmt.Println("Only the slots booked before the closure are left.")
+		}
+	}
+
 	// Check if there are any available slots
 	if len(slots) == 0 {
+		if joinableOnly {
+			fmt.Println("⚠️ Nobody is waiting for opponents in this game yet.")
+			return
+		}
 		fmt.Println("⚠️ No slots available for this game.")
 		return
 	}
+
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetAutoWrapText(false)
-	table.SetHeader([]string{"S.No", "Slot Timings"})
+	table.SetHeader([]string{"S.No", "Slot Timings", "Table", "Players", "Status"})
 
-	// Display the list of available slots to the user
-	fmt.Println("🕒 Available Slots:")
+	// Display the list of available slots to the user, coloured by how full they are
+	fmt.Printf("🕒 Available Slots on %s:\n", utils.FormatDay(date))
 	for i, slot := range slots {
-		if time.Now().After(slot.StartTime) {
-			table.Append([]string{
-				color.New(color.FgRed).Sprintf("#%d", i+1),
-				color.New(color.FgRed).Sprintf("%s - %s", slot.StartTime.Format("03:04 PM"), slot.EndTime.Format("03:04 PM")),
-			})
-		} else {
-			table.Append([]string{
-				color.New(color.FgBlue).Sprintf("#%d", i+1),
-				color.New(color.FgBlue).Sprintf("%s - %s", slot.StartTime.Format("03:04 PM"), slot.EndTime.Format("03:04 PM")),
-			})
+		rowColor, status := color.New(color.FgBlue), "Open"
+		switch {
+		case utils.Now().After(slot.StartTime):
+			rowColor, status = color.New(color.FgRed), "Past"
+		case slot.IsFull():
+			rowColor, status = color.New(color.FgMagenta), "Full"
+		case slot.IsWaitingForOpponents():
+			rowColor, status = color.New(color.FgGreen), "Waiting for opponents"
 		}
+		table.Append([]string{
+			rowColor.Sprintf("#%d", i+1),
+			rowColor.Sprintf("%s - %s", utils.FormatClock(slot.StartTime), utils.FormatClock(slot.EndTime)),
+			rowColor.Sprintf("Table %d", slot.Instance),
+			rowColor.Sprintf("%d/%d", slot.BookedCount, slot.Capacity),
+			rowColor.Sprint(status),
+		})
 	}
 
 	table.Render()
@@ -124,19 +172,65 @@ func (ui *UI) HandleSelectedGame(game *entities.Game) {
 	}
 
 	// Get the selected slot object
-	selectedSlot := slots[choice-1]
+	selectedSlot := slots[choice-1].Slot
 
 	// Pass the selected game and slot objects to another function
 	ui.HandleSelectedSlot(game, &selectedSlot)
 }
 
+// selectBookingDate lists the days of the booking horizon and returns the one chosen by the user.
+// It returns false if the user decides to go back.
+func (ui *UI) selectBookingDate() (time.Time, bool) {
+	today := utils.StartOfDay(utils.Now())
+
+	fmt.Println("📅 Select a day:")
+	for i := 0; i < config.BookingHorizonDays; i++ {
+		day := today.AddDate(0, 0, i)
+		switch i {
+		case 0:
+			fmt.Printf("%d. Today (%s)\n", i+1, utils.FormatDay(day))
+		case 1:
+			fmt.Printf("%d. Tomorrow (%s)\n", i+1, utils.FormatDay(day))
+		default:
+			fmt.Printf("%d. %s\n", i+1, utils.FormatDay(day))
+		}
+	}
+	fmt.Printf("%d. 🔙 Go Back\n", config.BookingHorizonDays+1)
+
+	var choice int
+	for {
+		fmt.Print("👉 Select a day by entering the corresponding number: ")
+		input, err := ui.reader.ReadString('\n')
+		if err != nil {
+			fmt.Println("❌ Error reading input:", err)
+			continue
+		}
+
+		// Convert the input to an integer
+		choice, err = strconv.Atoi(strings.TrimSpace(input))
+		if err != nil || choice < 1 || choice > config.BookingHorizonDays+1 {
+			fmt.Println("❗ Invalid input. Please enter a number corresponding to a day.")
+		} else {
+			break
+		}
+	}
+
+	if choice == config.BookingHorizonDays+1 {
+		return time.Time{}, false
+	}
+	return today.AddDate(0, 0, choice-1), true
+}
+
 // HandleSelectedSlot processes the selected game and slot entities.
 func (ui *UI) HandleSelectedSlot(game *entities.Game, slot *entities.Slot) {
 	bookedUsers, _ := ui.bookingService.GetSlotBookedUsers(context.Background(), slot.SlotID)
+	position, waiting, _ := ui.bookingService.GetWaitlistPosition(context.Background(), globals.ActiveUser, slot.SlotID)
 	// Display the selected slot's time and game name
 	fmt.Printf("\n📅 Slot Details:\n")
 	fmt.Printf("🎮 Game: %s\n", game.GameName)
-	fmt.Printf("⏰ Slot Time: %s to %s\n", slot.StartTime.Format("03:04 PM"), slot.EndTime.Format("03:04 PM"))
+	fmt.Printf("🎱 Table: %d\n", slot.Instance)
+	fmt.Printf("📆 Date: %s\n", utils.FormatDay(slot.StartTime))
+	fmt.Printf("⏰ Slot Time: %s to %s\n", utils.FormatClock(slot.StartTime), utils.FormatClock(slot.EndTime))
 
 	// Display booked users
 	if len(bookedUsers) == 0 {
@@ -148,11 +242,26 @@ func (ui *UI) HandleSelectedSlot(game *entities.Game, slot *entities.Slot) {
 		}
 	}
 
+	// Display the waitlist
+	if position > 0 {
+		fmt.Printf("⏳ Waitlist: you are #%d of %d waiting\n", position, waiting)
+	} else if waiting > 0 {
+		fmt.Printf("⏳ Waitlist: %d waiting\n", waiting)
+	}
+
 	// Show options to the user
 	fmt.Println("\n🔧 Options:")
-	fmt.Println("1. ✅ Book in this slot")
-	fmt.Println("2. ✉️ Invite to this slot")
-	fmt.Println("3. 🔙 Go back")
+	switch {
+	case position > 0:
+		fmt.Println("1. 🚪 Leave the waitlist")
+	case slot.IsBooked:
+		fmt.Println("1. ⏳ Join the waitlist")
+	default:
+		fmt.Println("1. ✅ Book in this slot")
+	}
+	fmt.Println("2. 👥 Book for a group")
+	fmt.Println("3. ✉️ Invite to this slot")
+	fmt.Println("4. 🔙 Go back")
 
 	// Handle user input
 	var choice int
@@ -169,8 +278,8 @@ func (ui *UI) HandleSelectedSlot(game *entities.Game, slot *entities.Slot) {
 
 		// Convert the input to an integer
 		choice, err = strconv.Atoi(input)
-		if err != nil || choice < 1 || choice > 3 {
-			fmt.Println("❗ Invalid input. Please enter a number between 1 and 3.")
+		if err != nil || choice < 1 || choice > 4 {
+			fmt.Println("❗ Invalid input. Please enter a number between 1 and 4.")
 		} else {
 			break
 		}
@@ -179,13 +288,48 @@ func (ui *UI) HandleSelectedSlot(game *entities.Game, slot *entities.Slot) {
 	// Process user choice
 	switch choice {
 	case 1:
+		if position > 0 {
+			if err := ui.bookingService.LeaveWaitlist(context.Background(), globals.ActiveUser, slot.SlotID); err != nil {
+				fmt.Println("❌", err)
+				return
+			}
+			fmt.Println("✅ You have left the waitlist.")
+			return
+		}
+		if slot.IsBooked {
+			ui.joinWaitlist(slot)
+			return
+		}
+
 		err := ui.bookingService.MakeBooking(context.Background(), globals.ActiveUser, slot.SlotID)
+		if errors.Is(err, domain_errors.ErrSlotFull) {
+			fmt.Print("⚠️ The slot has just filled up. Would you like to join the waitlist? (y/n): ")
+			answer, _ := ui.reader.ReadString('\n')
+			if strings.ToLower(strings.TrimSpace(answer)) == "y" {
+				ui.joinWaitlist(slot)
+			}
+			return
+		}
 		if err != nil {
 			fmt.Println("❌", err)
 			return
 		}
 		fmt.Println("🎉 Slot booked successfully!")
 	case 2:
+		fmt.Print("👥 Enter the emails or usernames of the other players, separated by commas: ")
+		input, err := ui.reader.ReadString('\n')
+		if err != nil {
+			fmt.Println("❌ Error reading players:", err)
+			return
+		}
+
+		members := strings.Split(input, ",")
+		if err := ui.bookingService.MakeGroupBooking(context.Background(), globals.ActiveUser, slot.SlotID, members); err != nil {
+			fmt.Println("❌", err)
+			return
+		}
+		fmt.Println("🎉 Your group has been booked! Everyone has been notified.")
+	case 3:
 		fmt.Print("✉️ Enter the email of the user you want to invite to the slot: ")
 		email, err := ui.reader.ReadString('\n')
 		if err != nil {
@@ -212,7 +356,17 @@ func (ui *UI) HandleSelectedSlot(game *entities.Game, slot *entities.Slot) {
 
 		fmt.Println("✉️ User invited to slot successfully!")
 
-	case 3:
+	case 4:
 		ui.ShowGameRoom()
 	}
 }
+
+// joinWaitlist queues the active user for the full slot and shows their position.
+func (ui *UI) joinWaitlist(slot *entities.Slot) {
+	position, err := ui.bookingService.JoinWaitlist(context.Background(), globals.ActiveUser, slot.SlotID)
+	if err != nil {
+		fmt.Println("❌", err)
+		return
+	}
+	fmt.Printf("⏳ You joined the waitlist at position #%d. You will be booked automatically if a seat opens up.\n", position)
+}
@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"project2/internal/domain/entities"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (ui *UI) ManageHolidays() {
+	for {
+		fmt.Println("\033[1;34m") // Blue bold
+		fmt.Println("\n📅 Holiday Calendar")
+		fmt.Println("\033[0m") // Reset color
+
+		holidays, err := ui.holidayService.GetUpcomingHolidays(context.Background())
+		if err != nil {
+			fmt.Printf("\033[1;31m❌ Error retrieving holidays: %v\033[0m\n", err)
+			return
+		}
+
+		if len(holidays) == 0 {
+			fmt.Println("No upcoming holidays.")
+		}
+		for i, holiday := range holidays {
+			fmt.Printf("%d. %s  %s\n", i+1, holiday.Date.Format("Mon, 02 Jan 2006"), holiday.Name)
+		}
+
+		fmt.Println("\n1. 📥 Import a calendar file (CSV, YAML or ICS)")
+		fmt.Println("2. ➕ Add a holiday")
+		fmt.Println("3. 🗑️ Remove a holiday")
+		fmt.Println("4. 🔙 Go back")
+		fmt.Print("\nEnter your choice: ")
+
+		input, _ := ui.reader.ReadString('\n')
+		switch strings.TrimSpace(input) {
+		case "1":
+			ui.ImportHolidays()
+		case "2":
+			ui.AddHoliday()
+		case "3":
+			ui.RemoveHoliday(holidays)
+		case "4":
+			return
+		default:
+			fmt.Println("\033[1;31m❌ Invalid choice. Please enter a number between 1 and 4.\033[0m")
+		}
+	}
+}
+
+// ImportHolidays asks for the path of a calendar file and imports its holidays
+func (ui *UI) ImportHolidays() {
+	fmt.Print("Enter the path of the calendar file: ")
+	path, _ := ui.reader.ReadString('\n')
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return
+	}
+
+	count, err := ui.holidayService.ImportHolidays(context.Background(), path)
+	if err != nil {
+		fmt.Printf("\033[1;31m❌ Error importing holidays: %v\033[0m\n", err)
+		return
+	}
+	fmt.Printf("\033[1;32m✅ %d holiday(s) imported! Their free slots were removed and players with bookings notified.\033[0m\n", count)
+}
+
+// AddHoliday asks for the date and name of a single holiday
+func (ui *UI) AddHoliday() {
+	date, ok := ui.readDate("Enter the date of the holiday (YYYY-MM-DD): ", time.Time{}, time.UTC)
+	if !ok {
+		return
+	}
+
+	fmt.Print("Enter the name of the holiday: ")
+	name, _ := ui.reader.ReadString('\n')
+
+	if err := ui.holidayService.AddHoliday(context.Background(), &entities.Holiday{Date: date, Name: name}); err != nil {
+		fmt.Printf("\033[1;31m❌ Error adding holiday: %v\033[0m\n", err)
+		return
+	}
+	fmt.Println("\033[1;32m✅ Holiday added! Its free slots were removed and players with bookings notified.\033[0m")
+}
+
+// RemoveHoliday deletes one of the listed holidays and asks for its slots to be generated
+func (ui *UI) RemoveHoliday(holidays []entities.Holiday) {
+	if len(holidays) == 0 {
+		return
+	}
+
+	fmt.Print("Enter the number of the holiday you want to remove (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	choice, err := strconv.Atoi(strings.TrimSpace(input))
+	if err == nil && choice == 0 {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(holidays) {
+		fmt.Println("\033[1;31m❌ Invalid choice. Please enter a valid number.\033[0m")
+		return
+	}
+
+	if err := ui.holidayService.DeleteHoliday(context.Background(), holidays[choice-1].Date); err != nil {
+		fmt.Printf("\033[1;31m❌ Error removing holiday: %v\033[0m\n", err)
+		return
+	}
+	ui.slotGenerator.Trigger()
+	fmt.Println("\033[1;32m✅ Holiday removed! Its slots will be available again shortly.\033[0m")
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/olekukonko/tablewriter"
+	"project2/internal/config"
 )
 
 func (ui *UI) ViewLeaderboard() {
@@ -66,24 +67,53 @@ func (ui *UI) ViewLeaderboard() {
 
 	// Step 4: Create a table for the leaderboard
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Rank 🥇", "Name 👤", "Score 💯"})
+	table.SetHeader([]string{"Rank 🥇", "Name 👤", "Rating 📈", "Games 🎮", "Score 💯"})
 
-	// Iterate through users and add them to the table
+	// Iterate through users and add them to the table, sorted by rating
+	provisional := false
 	for i, user := range users {
 		rank := i + 1
 		name := user.UserName
-		score := user.Score // Adjust this if your score field has a different name in the leaderboard for games
+		if user.Provisional {
+			name += " *"
+			provisional = true
+		}
 
 		// Add the row to the table
 		table.Append([]string{
 			fmt.Sprintf("#%d", rank),
 			name,
-			fmt.Sprintf("%.2f", score),
+			fmt.Sprintf("%.0f ±%.0f", user.Rating, user.RatingDeviation),
+			strconv.Itoa(user.GamesPlayed),
+			fmt.Sprintf("%.2f", user.Score),
 		})
 	}
 
 	// Render the table to the console
 	table.Render()
+	if provisional {
+		fmt.Printf("* Provisional rating: fewer than %d games played\n", config.ProvisionalGames)
+	}
+
+	// Step 5: Show the team leaderboard if teams of the game have played
+	teams, err := ui.teamService.GetTeamLeaderboard(context.Background(), selectedGame.GameID)
+	if err != nil {
+		fmt.Println("⚠️ Error fetching team leaderboard:", err)
+	} else if len(teams) > 0 {
+		fmt.Printf("\n👥 Team Leaderboard for %s 👥\n", selectedGame.GameName)
+		teamTable := tablewriter.NewWriter(os.Stdout)
+		teamTable.SetHeader([]string{"Rank 🥇", "Team 👥", "Players 👤", "W / D / L 📊", "Rating 📈"})
+		for i, team := range teams {
+			teamTable.Append([]string{
+				fmt.Sprintf("#%d", i+1),
+				team.TeamName,
+				strings.Join(team.Members, ", "),
+				fmt.Sprintf("%d / %d / %d", team.Wins, team.Draws, team.Losses),
+				fmt.Sprintf("%.0f ±%.0f", team.Rating, team.RatingDeviation),
+			})
+		}
+		teamTable.Render()
+	}
 
 	fmt.Println("🏅 Keep playing to improve your rank!")
 }
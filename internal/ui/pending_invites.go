@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"project2/pkg/globals"
+	"project2/pkg/utils"
 	"strconv"
 	"strings"
 )
@@ -28,8 +29,8 @@ func (ui *UI) ViewPendingInvites() {
 	fmt.Println("You have the following pending invites:")
 	for i, invite := range invites {
 		fmt.Printf(" %d️⃣  %s\n", i+1, invite.GameName)
-		fmt.Printf("   🗓️  Date: %s\n", invite.Date.Format("2001-01-01"))
-		fmt.Printf("   🕒  Time: %s - %s\n", invite.StartTime.Format("03:04 PM"), invite.EndTime.Format("03:04 PM"))
+		fmt.Printf("   🗓️  Date: %s\n", utils.FormatDay(invite.StartTime))
+		fmt.Printf("   🕒  Time: %s - %s\n", utils.FormatClock(invite.StartTime), utils.FormatClock(invite.EndTime))
 		fmt.Println("   👥  Participants:")
 		for _, user := range invite.BookedUsers {
 			fmt.Printf("    - %s\n", user)
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	domain_errors "project2/internal/domain/errors"
+	"project2/internal/models"
+	"project2/pkg/globals"
+	"project2/pkg/utils"
+	"strconv"
+	"strings"
+)
+
+// QuickPlay searches the soonest free slots across every game and books the one chosen by the user.
+func (ui *UI) QuickPlay() {
+	fmt.Println("\n⚡ Quick Play")
+
+	search, ok := ui.readSlotSearch()
+	if !ok {
+		return
+	}
+
+	slots, err := ui.slotService.FindFreeSlots(context.Background(), search)
+	if err != nil {
+		fmt.Println("❌ Error searching slots:", err)
+		return
+	}
+	if len(slots) == 0 {
+		fmt.Println("⚠️ No slot has enough free seats. Try other games or times.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetAutoWrapText(false)
+	table.SetHeader([]string{"S.No", "Game", "Day", "Slot Timings", "Table", "Free Seats"})
+	for i, slot := range slots {
+		table.Append([]string{
+			fmt.Sprintf("#%d", i+1),
+			slot.GameName,
+			utils.FormatDay(slot.StartTime),
+			fmt.Sprintf("%s - %s", utils.FormatClock(slot.StartTime), utils.FormatClock(slot.EndTime)),
+			fmt.Sprintf("Table %d", slot.Instance),
+			strconv.Itoa(slot.FreeSeats),
+		})
+	}
+	table.Render()
+
+	fmt.Print("👉 Enter the number of the slot to book (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	choice, err := strconv.Atoi(strings.TrimSpace(input))
+	if err == nil && choice == 0 {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(slots) {
+		fmt.Println("❗ Invalid input. Please enter a number corresponding to a slot.")
+		return
+	}
+	ui.quickBook(slots[choice-1], search.SeatsNeeded)
+}
+
+// readSlotSearch asks for the optional filters of a free slot search. It returns false if the input is invalid.
+func (ui *UI) readSlotSearch() (models.SlotSearch, bool) {
+	var search models.SlotSearch
+
+	games, err := ui.gameService.GetAllGames(context.Background())
+	if err != nil {
+		fmt.Println("❌ Error fetching games:", err)
+		return search, false
+	}
+	for i, game := range games {
+		fmt.Printf("%d. %s\n", i+1, game.GameName)
+	}
+	fmt.Print("🎮 Enter the numbers of the games you fancy, separated by commas [any game]: ")
+	input, _ := ui.reader.ReadString('\n')
+	for _, field := range strings.Split(input, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		choice, err := strconv.Atoi(field)
+		if err != nil || choice < 1 || choice > len(games) {
+			fmt.Printf("❗ Invalid game number %q.\n", field)
+			return search, false
+		}
+		search.GameIDs = append(search.GameIDs, games[choice-1].GameID)
+	}
+
+	today := utils.StartOfDay(utils.Now())
+	earliest, ok := ui.readTimeOfDay("⏰ Earliest start today HH:MM [now]: ", "")
+	if !ok {
+		return search, false
+	}
+	if earliest != "" {
+		search.EarliestStart = atTimeOfDay(today, earliest)
+	}
+	latest, ok := ui.readTimeOfDay("⏰ Latest start today HH:MM [any time]: ", "")
+	if !ok {
+		return search, false
+	}
+	if latest != "" {
+		search.LatestStart = atTimeOfDay(today, latest)
+	}
+
+	fmt.Print("👥 How many seats do you need? [1]: ")
+	input, _ = ui.reader.ReadString('\n')
+	search.SeatsNeeded = 1
+	if input = strings.TrimSpace(input); input != "" {
+		seats, err := strconv.Atoi(input)
+		if err != nil || seats < 1 {
+			fmt.Println("❗ Invalid number of seats.")
+			return search, false
+		}
+		search.SeatsNeeded = seats
+	}
+
+	return search, true
+}
+
+// quickBook books the user into the slot, together with the other players when more than one seat is needed
+func (ui *UI) quickBook(slot models.FreeSlot, seats int) {
+	if seats == 1 {
+		err := ui.bookingService.MakeBooking(context.Background(), globals.ActiveUser, slot.SlotId)
+		if errors.Is(err, domain_errors.ErrSlotFull) {
+			fmt.Println("⚠️ The slot has just filled up. Please search again.")
+			return
+		}
+		if err != nil {
+			fmt.Println("❌", err)
+			return
+		}
+		fmt.Printf("🎉 Booked into %s on %s at %s!\n", slot.GameName, utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime))
+		return
+	}
+
+	fmt.Printf("👥 Enter the emails or usernames of the other %d players, separated by commas: ", seats-1)
+	input, _ := ui.reader.ReadString('\n')
+	members := strings.Split(input, ",")
+	if err := ui.bookingService.MakeGroupBooking(context.Background(), globals.ActiveUser, slot.SlotId, members); err != nil {
+		fmt.Println("❌", err)
+		return
+	}
+	fmt.Printf("🎉 Your group is booked into %s on %s at %s! Everyone has been notified.\n", slot.GameName, utils.FormatDay(slot.StartTime), utils.FormatClock(slot.StartTime))
+}
@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"project2/pkg/globals"
+	"project2/pkg/utils"
+	"project2/pkg/validation"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (ui *UI) ViewRecurringBookings() {
+	fmt.Println("\n=============================== Your Recurring Bookings ===============================")
+
+	recurrences, err := ui.recurringService.GetUserRecurringBookings(context.Background(), globals.ActiveUser)
+	if err != nil {
+		fmt.Printf("Error retrieving recurring bookings: %v\n", err)
+		return
+	}
+
+	if len(recurrences) == 0 {
+		fmt.Println("You have no recurring bookings.")
+	}
+
+	for i, recurrence := range recurrences {
+		weekdays := make([]string, len(recurrence.Weekdays))
+		for j, weekday := range recurrence.Weekdays {
+			weekdays[j] = weekday.String()[:3]
+		}
+
+		fmt.Printf("Recurrence #%d\n", i+1)
+		fmt.Printf("Game:         %s\n", recurrence.GameName)
+		fmt.Printf("Days:         %s\n", strings.Join(weekdays, ", "))
+		fmt.Printf("Start Time:   %s %s\n", recurrence.StartTime, utils.ZoneName(utils.Now()))
+		fmt.Printf("Until:        %s\n", recurrence.EndDate.Format("Mon, 02 Jan 2006"))
+
+		if i < len(recurrences)-1 {
+			fmt.Println(strings.Repeat("-", 80))
+		}
+	}
+
+	fmt.Println("\n======================================================================================")
+
+	fmt.Println("\n🔧 Options:")
+	fmt.Println("1. ➕ Add recurring booking")
+	fmt.Println("2. ❌ Delete recurring booking")
+	fmt.Println("3. 🔙 Go back")
+	fmt.Print("👉 Select an option by entering the corresponding number: ")
+
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	switch input {
+	case "1":
+		ui.AddRecurringBooking()
+	case "2":
+		ui.DeleteRecurringBooking(recurrences)
+	case "3":
+		return
+	default:
+		fmt.Println("❗ Invalid input. Please enter a number between 1 and 3.")
+	}
+}
+
+// AddRecurringBooking asks for the game, weekdays, start time and end date of a new recurrence
+// and reports the occurrences that could not be booked.
+func (ui *UI) AddRecurringBooking() {
+	game, ok := ui.selectGame()
+	if !ok {
+		return
+	}
+
+	recurrence := &entities.RecurringBooking{UserID: globals.ActiveUser, GameID: game.GameID}
+
+	for {
+		fmt.Print("Enter the days to play on (e.g. Tue, Thu): ")
+		input, _ := ui.reader.ReadString('\n')
+		weekdays, err := utils.ParseWeekdays(input)
+		if err != nil {
+			fmt.Println("❌", err)
+			continue
+		}
+		recurrence.Weekdays = weekdays
+		break
+	}
+
+	for {
+		fmt.Print("Enter the start time HH:MM (e.g. 13:00): ")
+		input, _ := ui.reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if !validation.IsValidTimeOfDay(input) {
+			fmt.Println("❌ Invalid time. Please use the HH:MM format.")
+			continue
+		}
+		recurrence.StartTime = input
+		break
+	}
+
+	for {
+		fmt.Print("Enter the last date to play on (YYYY-MM-DD): ")
+		input, _ := ui.reader.ReadString('\n')
+		endDate, err := time.Parse("2006-01-02", strings.TrimSpace(input))
+		if err != nil {
+			fmt.Println("❌ Invalid date. Please use the YYYY-MM-DD format.")
+			continue
+		}
+		recurrence.EndDate = endDate
+		break
+	}
+
+	conflicts, err := ui.recurringService.CreateRecurringBooking(context.Background(), recurrence)
+	if err != nil {
+		fmt.Println("❌ Error creating recurring booking:", err)
+		return
+	}
+
+	fmt.Println("✅ Recurring booking created! Upcoming sessions are booked automatically.")
+	printBookingConflicts(conflicts)
+}
+
+// DeleteRecurringBooking asks the user which of the listed recurrences to stop and deletes it.
+func (ui *UI) DeleteRecurringBooking(recurrences []models.RecurringBookings) {
+	if len(recurrences) == 0 {
+		return
+	}
+
+	fmt.Print("Enter the number of the recurring booking you want to delete (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	choice, err := strconv.Atoi(input)
+	if choice == 0 && err == nil {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(recurrences) {
+		fmt.Println("❌ Invalid choice. Please enter a valid number.")
+		return
+	}
+
+	err = ui.recurringService.DeleteRecurringBooking(context.Background(), globals.ActiveUser, recurrences[choice-1].RecurrenceId)
+	if err != nil {
+		fmt.Println("❌ Error deleting recurring booking:", err)
+		return
+	}
+	fmt.Printf("✅ Recurring booking #%d deleted. Sessions already booked can be cancelled from your upcoming bookings.\n", choice)
+}
+
+// printBookingConflicts lists the sessions that could not be booked
+func printBookingConflicts(conflicts []models.BookingConflict) {
+	if len(conflicts) == 0 {
+		return
+	}
+
+	fmt.Println("⚠️ The following sessions could not be booked:")
+	for _, conflict := range conflicts {
+		fmt.Printf("- %s on %s at %s: %s\n", conflict.GameName, utils.FormatDay(conflict.StartTime), utils.FormatClock(conflict.StartTime), conflict.Reason)
+	}
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"project2/pkg/globals"
+	"strconv"
+	"strings"
+)
+
+// ViewTeams lists the user's fixed teams and lets them register a new team or disband one
+func (ui *UI) ViewTeams() {
+	fmt.Println("\n=============================== Your Teams ===============================")
+
+	teams, err := ui.teamService.GetUserTeams(context.Background(), globals.ActiveUser)
+	if err != nil {
+		fmt.Printf("Error retrieving teams: %v\n", err)
+		return
+	}
+
+	if len(teams) == 0 {
+		fmt.Println("You are not in any team yet.")
+	}
+
+	for i, team := range teams {
+		fmt.Printf("Team #%d\n", i+1)
+		fmt.Printf("Name:         %s\n", team.Name)
+		fmt.Printf("Game:         %s\n", team.GameName)
+		fmt.Printf("Players:      %s\n", strings.Join(team.Members, ", "))
+		fmt.Printf("Record:       %d W / %d D / %d L\n", team.Wins, team.Draws, team.Losses)
+		fmt.Printf("Rating:       %.0f\n", team.Rating)
+
+		if i < len(teams)-1 {
+			fmt.Println(strings.Repeat("-", 80))
+		}
+	}
+
+	fmt.Println("\n======================================================================================")
+
+	fmt.Println("\n🔧 Options:")
+	fmt.Println("1. ➕ Create a team")
+	fmt.Println("2. ❌ Disband a team")
+	fmt.Println("3. 🔙 Go back")
+	fmt.Print("👉 Select an option by entering the corresponding number: ")
+
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	switch input {
+	case "1":
+		ui.CreateTeam()
+	case "2":
+		if len(teams) == 0 {
+			return
+		}
+		fmt.Print("Enter the number of the team you want to disband (0 to go back): ")
+		input, _ := ui.reader.ReadString('\n')
+		choice, err := strconv.Atoi(strings.TrimSpace(input))
+		if choice == 0 && err == nil {
+			return
+		}
+		if err != nil || choice < 1 || choice > len(teams) {
+			fmt.Println("❌ Invalid choice. Please enter a valid number.")
+			return
+		}
+		if err := ui.teamService.DisbandTeam(context.Background(), globals.ActiveUser, teams[choice-1].TeamId); err != nil {
+			fmt.Println("❌ Error disbanding team:", err)
+			return
+		}
+		fmt.Println("✅ Team disbanded.")
+	case "3":
+		return
+	default:
+		fmt.Println("❗ Invalid input. Please enter a number between 1 and 3.")
+	}
+}
+
+// CreateTeam registers a fixed team of the user and their teammates for a game. Matches in which exactly these
+// players made up a side count towards the team leaderboard of the game.
+func (ui *UI) CreateTeam() {
+	game, ok := ui.selectGame()
+	if !ok {
+		return
+	}
+
+	fmt.Print("Enter the name of the team: ")
+	name, _ := ui.reader.ReadString('\n')
+
+	fmt.Print("👥 Enter the emails or usernames of your teammates, separated by commas: ")
+	input, _ := ui.reader.ReadString('\n')
+	members := strings.Split(input, ",")
+
+	if _, err := ui.teamService.CreateTeam(context.Background(), globals.ActiveUser, game.GameID, name, members); err != nil {
+		fmt.Println("❌ Error creating team:", err)
+		return
+	}
+	fmt.Printf("✅ Team %s created! Report your %s matches with your teammates on your side to climb the team leaderboard.\n", strings.TrimSpace(name), game.GameName)
+}
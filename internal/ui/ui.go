@@ -5,6 +5,11 @@ import (
 	"project2/internal/domain/interfaces/service"
 )
 
+// SlotGenerator generates the missing slots of the booking horizon when asked to
+type SlotGenerator interface {
+	Trigger()
+}
+
 // UI struct holds the UserService, bufio.Reader, and other dependencies
 type UI struct {
 	userService         service_interfaces.UserService
@@ -14,11 +19,17 @@ type UI struct {
 	invitationService   service_interfaces.InvitationService
 	leaderboardService  service_interfaces.LeaderboardService
 	notificationService service_interfaces.NotificationService
+	recurringService    service_interfaces.RecurringBookingService
+	blackoutService     service_interfaces.BlackoutService
+	holidayService      service_interfaces.HolidayService
+	resultService       service_interfaces.ResultService
+	teamService         service_interfaces.TeamService
+	slotGenerator       SlotGenerator
 	reader              *bufio.Reader
 }
 
 // NewUI initializes the UI with the provided services and a bufio.Reader
-func NewUI(userService service_interfaces.UserService, gameService service_interfaces.GameService, slotService service_interfaces.SlotService, bookingService service_interfaces.BookingService, invitationService service_interfaces.InvitationService, leaderboardService service_interfaces.LeaderboardService, notificationService service_interfaces.NotificationService, reader *bufio.Reader) *UI {
+func NewUI(userService service_interfaces.UserService, gameService service_interfaces.GameService, slotService service_interfaces.SlotService, bookingService service_interfaces.BookingService, invitationService service_interfaces.InvitationService, leaderboardService service_interfaces.LeaderboardService, notificationService service_interfaces.NotificationService, recurringService service_interfaces.RecurringBookingService, blackoutService service_interfaces.BlackoutService, holidayService service_interfaces.HolidayService, resultService service_interfaces.ResultService, teamService service_interfaces.TeamService, slotGenerator SlotGenerator, reader *bufio.Reader) *UI {
 	return &UI{
 		userService:         userService,
 		gameService:         gameService,
@@ -27,6 +38,12 @@ func NewUI(userService service_interfaces.UserService, gameService service_inter
 		invitationService:   invitationService,
 		leaderboardService:  leaderboardService,
 		notificationService: notificationService,
+		recurringService:    recurringService,
+		blackoutService:     blackoutService,
+		holidayService:      holidayService,
+		resultService:       resultService,
+		teamService:         teamService,
+		slotGenerator:       slotGenerator,
 		reader:              reader,
 	}
 }
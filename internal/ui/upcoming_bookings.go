@@ -2,8 +2,15 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"project2/internal/models"
 	"project2/pkg/globals"
+	"project2/pkg/utils"
+	"strconv"
 	"strings"
 )
 
@@ -25,8 +32,11 @@ func (ui *UI) ViewUpcomingBookings() {
 
 		fmt.Printf("Booking #%d\n", i+1)
 		fmt.Printf("Game:         %s\n", booking.GameName)
-		fmt.Printf("Start Time:   %s IST\n", booking.StartTime.Format("03:04 PM"))
-		fmt.Printf("End Time:     %s IST\n", booking.EndTime.Format("03:04 PM"))
+		fmt.Printf("Table:        %d\n", booking.Instance)
+		fmt.Printf("Date:         %s\n", booking.StartTime.In(config.Location()).Format("Mon, 02 Jan 2006"))
+		fmt.Printf("Start Time:   %s %s\n", utils.FormatClock(booking.StartTime), utils.ZoneName(booking.StartTime))
+		fmt.Printf("End Time:     %s %s\n", utils.FormatClock(booking.EndTime), utils.ZoneName(booking.EndTime))
+		fmt.Printf("Check-in:     code %s, %d minutes either side of the start time\n", booking.CheckInCode, config.CheckInWindowMinutes)
 
 		if len(booking.BookedUsers) > 0 {
 			fmt.Println("Participants: ")
@@ -43,4 +53,173 @@ func (ui *UI) ViewUpcomingBookings() {
 	}
 
 	fmt.Println("\n======================================================================================")
+
+	fmt.Println("\n🔧 Options:")
+	fmt.Println("1. ❌ Cancel booking")
+	fmt.Println("2. 🔁 Reschedule booking")
+	fmt.Println("3. 🤝 Transfer booking to a colleague")
+	fmt.Println("4. 🔙 Go back")
+	fmt.Print("👉 Select an option by entering the corresponding number: ")
+
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	switch input {
+	case "1":
+		ui.CancelBooking(bookings)
+	case "2":
+		ui.RescheduleBooking(bookings)
+	case "3":
+		ui.TransferBooking(bookings)
+	case "4":
+		return
+	default:
+		fmt.Println("❗ Invalid input. Please enter a number between 1 and 4.")
+	}
+}
+
+// CancelBooking asks the user which of the listed bookings to drop out of and cancels it.
+func (ui *UI) CancelBooking(bookings []models.Bookings) {
+	fmt.Print("Enter the number of the booking you want to cancel (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	choice, err := strconv.Atoi(input)
+	if choice == 0 && err == nil {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(bookings) {
+		fmt.Println("❌ Invalid choice. Please enter a valid number.")
+		return
+	}
+
+	selectedBooking := bookings[choice-1]
+	fmt.Printf("Cancel your %s booking at %s? (y/n): ", selectedBooking.GameName, utils.FormatClock(selectedBooking.StartTime))
+	confirm, _ := ui.reader.ReadString('\n')
+	if strings.ToLower(strings.TrimSpace(confirm)) != "y" {
+		fmt.Println("Booking kept.")
+		return
+	}
+
+	err = ui.bookingService.CancelBooking(context.Background(), globals.ActiveUser, selectedBooking.BookingId)
+	if err != nil {
+		fmt.Println("❌ Error cancelling booking:", err)
+		return
+	}
+	fmt.Printf("✅ Booking #%d cancelled\n", choice)
+}
+
+// RescheduleBooking asks the user which of the listed bookings to move and lists the free slots of the same game to move it to.
+func (ui *UI) RescheduleBooking(bookings []models.Bookings) {
+	fmt.Print("Enter the number of the booking you want to reschedule (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	choice, err := strconv.Atoi(input)
+	if choice == 0 && err == nil {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(bookings) {
+		fmt.Println("❌ Invalid choice. Please enter a valid number.")
+		return
+	}
+	selectedBooking := bookings[choice-1]
+
+	currentSlot, err := ui.slotService.GetSlotByID(context.Background(), selectedBooking.SlotId)
+	if err != nil || currentSlot == nil {
+		fmt.Println("❌ Error fetching the booked slot:", err)
+		return
+	}
+
+	date, ok := ui.selectBookingDate()
+	if !ok {
+		return
+	}
+	slots, err := ui.slotService.GetGameSlotsByDate(context.Background(), currentSlot.GameID, date)
+	if err != nil {
+		fmt.Println("❌ Error fetching slots:", err)
+		return
+	}
+
+	// Only offer the slots that can still be booked
+	var freeSlots []entities.Slot
+	for _, slot := range slots {
+		if slot.SlotID != currentSlot.SlotID && !slot.IsBooked && slot.StartTime.After(utils.Now()) {
+			freeSlots = append(freeSlots, slot)
+		}
+	}
+	if len(freeSlots) == 0 {
+		fmt.Printf("⚠️ No free %s slots on %s.\n", selectedBooking.GameName, utils.FormatDay(date))
+		return
+	}
+
+	fmt.Printf("🕒 Free %s slots on %s:\n", selectedBooking.GameName, utils.FormatDay(date))
+	for i, slot := range freeSlots {
+		fmt.Printf("%d. %s - %s (Table %d)\n", i+1, utils.FormatClock(slot.StartTime), utils.FormatClock(slot.EndTime), slot.Instance)
+	}
+	fmt.Print("Enter the number of the new slot (0 to go back): ")
+	input, _ = ui.reader.ReadString('\n')
+	slotChoice, err := strconv.Atoi(strings.TrimSpace(input))
+	if slotChoice == 0 && err == nil {
+		return
+	}
+	if err != nil || slotChoice < 1 || slotChoice > len(freeSlots) {
+		fmt.Println("❌ Invalid choice. Please enter a valid number.")
+		return
+	}
+	newSlot := freeSlots[slotChoice-1]
+
+	err = ui.bookingService.RescheduleBooking(context.Background(), globals.ActiveUser, selectedBooking.BookingId, newSlot.SlotID)
+	if errors.Is(err, domain_errors.ErrSlotFull) {
+		fmt.Println("⚠️ That slot has just filled up. Your booking was kept.")
+		return
+	}
+	if err != nil {
+		fmt.Println("❌ Error rescheduling booking, your booking was kept:", err)
+		return
+	}
+	fmt.Printf("✅ Booking #%d moved to %s at %s\n", choice, utils.FormatDay(newSlot.StartTime), utils.FormatClock(newSlot.StartTime))
+}
+
+// TransferBooking asks the user which of the listed bookings to hand over and to whom.
+func (ui *UI) TransferBooking(bookings []models.Bookings) {
+	fmt.Print("Enter the number of the booking you want to transfer (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	choice, err := strconv.Atoi(input)
+	if choice == 0 && err == nil {
+		return
+	}
+	if err != nil || choice < 1 || choice > len(bookings) {
+		fmt.Println("❌ Invalid choice. Please enter a valid number.")
+		return
+	}
+
+	fmt.Print("Enter the email or username of the colleague taking your seat: ")
+	recipient, _ := ui.reader.ReadString('\n')
+
+	err = ui.bookingService.TransferBooking(context.Background(), globals.ActiveUser, bookings[choice-1].BookingId, recipient)
+	if err != nil {
+		fmt.Println("❌ Error transferring booking:", err)
+		return
+	}
+	fmt.Printf("✅ Booking #%d transferred\n", choice)
+}
+
+// CheckIn asks the user for the check-in code of the booking they have turned up for
+func (ui *UI) CheckIn() {
+	fmt.Print("Enter the check-in code shown on your booking: ")
+	code, _ := ui.reader.ReadString('\n')
+
+	err := ui.bookingService.CheckIn(context.Background(), globals.ActiveUser, code)
+	if errors.Is(err, domain_errors.ErrInvalidCheckInCode) {
+		fmt.Printf("❌ Invalid code. You can check in from %d minutes before until %d minutes after your slot starts.\n", config.CheckInWindowMinutes, config.CheckInWindowMinutes)
+		return
+	}
+	if err != nil {
+		fmt.Println("❌ Error checking in:", err)
+		return
+	}
+	fmt.Println("✅ Checked in. Enjoy your game!")
 }
@@ -1,108 +1,255 @@
 package ui
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"github.com/google/uuid"
-	"os"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
 	"project2/pkg/globals"
+	"project2/pkg/utils"
 	"strconv"
 	"strings"
 )
 
 func (ui *UI) UpdateResults() {
-	fmt.Println("\n=============================== Results to Update ===============================")
+	for {
+		awaiting, err := ui.resultService.GetResultsAwaitingConfirmation(context.Background(), globals.ActiveUser)
+		if err != nil {
+			fmt.Printf("Error retrieving results: %v\n", err)
+			return
+		}
+
+		fmt.Println("\n=============================== Match Results ===============================")
+		fmt.Println("1. 📝 Report the result of a match")
+		fmt.Printf("2. ✅ Confirm or dispute reported results (%d awaiting you)\n", len(awaiting))
+		fmt.Println("3. 🔙 Go back")
+		fmt.Print("\nEnter your choice: ")
+
+		input, _ := ui.reader.ReadString('\n')
+		switch strings.TrimSpace(input) {
+		case "1":
+			ui.ReportResult()
+		case "2":
+			ui.ConfirmResults(awaiting)
+		case "3":
+			return
+		default:
+			fmt.Println("Invalid choice. Please enter a number between 1 and 3.")
+		}
+	}
+}
+
+// ReportResult lists the user's finished matches without a result and reports the winner of the selected one.
+// The other players are asked to confirm it before the leaderboard is updated.
+func (ui *UI) ReportResult() {
+	fmt.Println("\n=============================== Results to Report ===============================")
 
-	gameHistoryList, err := ui.bookingService.GetBookingsToUpdateResult(context.Background(), globals.ActiveUser)
+	matches, err := ui.resultService.GetUnreportedMatches(context.Background(), globals.ActiveUser)
 	if err != nil {
-		fmt.Printf("Error retrieving results: %v\n", err)
+		fmt.Printf("Error retrieving matches: %v\n", err)
 		return
 	}
 
-	if len(gameHistoryList) == 0 {
-		fmt.Println("No results to update.")
+	if len(matches) == 0 {
+		fmt.Println("No results to report.")
 		return
 	}
 
-	for i, gameHistory := range gameHistoryList {
-
+	for i, match := range matches {
 		fmt.Printf(" #%d\n", i+1)
-		fmt.Printf("Game:         %s\n", gameHistory.GameName)
-		fmt.Printf("Start Time:   %s IST\n", gameHistory.StartTime.Format("03:04 PM"))
-		fmt.Printf("End Time:     %s IST\n", gameHistory.EndTime.Format("03:04 PM"))
-
-		if len(gameHistory.BookedUsers) > 0 {
-			fmt.Println("Participants:")
-			for _, userName := range gameHistory.BookedUsers {
-				fmt.Printf("- %s\n", userName)
-			}
-		} else {
-			fmt.Println("Participants: None")
+		fmt.Printf("Game:         %s\n", match.GameName)
+		fmt.Printf("Date:         %s\n", utils.FormatDay(match.StartTime))
+		fmt.Printf("Start Time:   %s %s\n", utils.FormatClock(match.StartTime), utils.ZoneName(match.StartTime))
+		fmt.Printf("End Time:     %s %s\n", utils.FormatClock(match.EndTime), utils.ZoneName(match.EndTime))
+		fmt.Println("Participants:")
+		for _, userName := range match.BookedUsers {
+			fmt.Printf("- %s\n", userName)
 		}
-
 		fmt.Println(strings.Repeat("-", 80))
 	}
 
-	fmt.Println("Press the corresponding number to update the result of that game:")
+	fmt.Print("Enter the number of the match to report (0 to go back): ")
+	input, _ := ui.reader.ReadString('\n')
+	index, err := strconv.Atoi(strings.TrimSpace(input))
+	if err == nil && index == 0 {
+		return
+	}
+	if err != nil || index < 1 || index > len(matches) {
+		fmt.Println("Invalid selection. Please enter a valid number.")
+		return
+	}
+	match := matches[index-1]
 
-	// Read user input for selection
-	reader := bufio.NewReader(os.Stdin)
-	input, _ := reader.ReadString('\n')
-	input = strings.TrimSpace(input)
+	participants, err := ui.resultService.GetMatchParticipants(context.Background(), match.SlotId)
+	if err != nil {
+		fmt.Printf("Error retrieving players: %v\n", err)
+		return
+	}
+	players, ok := ui.readMatchOutcome(participants)
+	if !ok {
+		return
+	}
 
-	// Parse the input to get the index
-	index, err := strconv.Atoi(input)
-	if err != nil || index < 1 || index > len(gameHistoryList) {
-		fmt.Println("Invalid selection. Please enter a valid number.")
+	if _, err := ui.resultService.ReportResult(context.Background(), globals.ActiveUser, match.SlotId, players); err != nil {
+		fmt.Printf("Error reporting result: %v\n", err)
 		return
 	}
+	fmt.Println("Result reported! The other players have been asked to confirm it.")
+}
 
-	// Adjust index to match slice (0-based indexing)
-	index = index - 1
+// ConfirmResults walks the user through the results reported by the other players of their matches
+func (ui *UI) ConfirmResults(results []models.ResultReport) {
+	if len(results) == 0 {
+		fmt.Println("No results are awaiting your confirmation.")
+		return
+	}
 
-	// Confirm the selection and prompt for result update
-	selectedGameHistory := gameHistoryList[index]
-	fmt.Printf("Selected Game: %s\n", selectedGameHistory.BookingId)
-	fmt.Println("Press 'w' for Win or 'l' for Loss:")
+	for _, result := range results {
+		fmt.Println(strings.Repeat("-", 80))
+		fmt.Printf("Game:         %s\n", result.GameName)
+		fmt.Printf("Played:       %s at %s %s\n", utils.FormatDay(result.StartTime), utils.FormatClock(result.StartTime), utils.ZoneName(result.StartTime))
+		fmt.Printf("Reported by:  %s\n", result.ReportedBy)
+		fmt.Printf("Result:       %s\n", utils.FormatMatchResult(result.Players))
+		fmt.Printf("Confirm by:   %s at %s %s\n", utils.FormatDay(result.ConfirmBy), utils.FormatClock(result.ConfirmBy), utils.ZoneName(result.ConfirmBy))
+		fmt.Print("Press 'c' to confirm, 'd' to dispute or Enter to skip: ")
 
-	resultInput, _ := reader.ReadString('\n')
-	resultInput = strings.TrimSpace(strings.ToUpper(resultInput))
+		input, _ := ui.reader.ReadString('\n')
+		var confirmed bool
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case "c":
+			confirmed = true
+		case "d":
+			confirmed = false
+		default:
+			continue
+		}
 
-	switch resultInput {
-	case "W":
-		// Update the result as a win
-		games, _ := ui.gameService.GetAllGames(context.Background())
-		var gameId uuid.UUID
-		for _, game := range games {
-			if game.GameName == selectedGameHistory.GameName {
-				gameId = game.GameID
-				break
-			}
+		if err := ui.resultService.RespondToResult(context.Background(), globals.ActiveUser, result.MatchId, confirmed); err != nil {
+			fmt.Printf("Error updating result: %v\n", err)
+			continue
 		}
-		err = ui.leaderboardService.AddWinToUser(context.Background(), globals.ActiveUser, gameId, selectedGameHistory.BookingId)
-		if err != nil {
-			fmt.Printf("Error adding win to user: %v\n", err)
+		if confirmed {
+			fmt.Println("Result confirmed!")
 		} else {
-			fmt.Println("Result updated to Win!")
-		}
-	case "L":
-		// Update the result as a loss
-		games, _ := ui.gameService.GetAllGames(context.Background())
-		var gameId uuid.UUID
-		for _, game := range games {
-			if game.GameName == selectedGameHistory.GameName {
-				gameId = game.GameID
-				break
-			}
-		}
-		err = ui.leaderboardService.AddLossToUser(context.Background(), globals.ActiveUser, gameId, selectedGameHistory.BookingId)
-		if err != nil {
-			fmt.Printf("Error adding win to user: %v\n", err)
+			fmt.Println("Result disputed. An admin will decide the outcome.")
+		}
+	}
+}
+
+// readMatchOutcome asks which side every player was on, whether the match was a draw or which side won it, and
+// for the optional score of every side.
+func (ui *UI) readMatchOutcome(participants []models.MatchParticipant) ([]entities.MatchPlayer, bool) {
+	sides, ok := ui.readMatchSides(participants)
+	if !ok {
+		return nil, false
+	}
+	labels := make([]string, len(sides))
+	for i, side := range sides {
+		names := make([]string, len(side))
+		for j, participant := range side {
+			names[j] = participant.Username
+		}
+		labels[i] = strings.Join(names, " & ")
+	}
+
+	fmt.Print("Was the match a draw? (y/n): ")
+	input, _ := ui.reader.ReadString('\n')
+	draw := strings.ToLower(strings.TrimSpace(input)) == "y"
+
+	var winner int
+	if !draw {
+		fmt.Println("Who won the match?")
+		for i, label := range labels {
+			fmt.Printf("%d. %s\n", i+1, label)
+		}
+		fmt.Print("Enter the number of the winner: ")
+
+		input, _ = ui.reader.ReadString('\n')
+		choice, err := strconv.Atoi(strings.TrimSpace(input))
+		if err != nil || choice < 1 || choice > len(sides) {
+			fmt.Println("Invalid selection. Please enter a valid number.")
+			return nil, false
+		}
+		winner = choice
+	}
+
+	scores := make([]*int, len(sides))
+	for i, label := range labels {
+		if i == 0 {
+			fmt.Printf("Enter the score of %s (leave empty if the match had no score): ", label)
 		} else {
-			fmt.Println("Result updated to Loss!")
+			fmt.Printf("Enter the score of %s: ", label)
+		}
+		input, _ = ui.reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if input == "" && i == 0 {
+			break
+		}
+		score, err := strconv.Atoi(input)
+		if err != nil || score < 0 {
+			fmt.Println("Invalid score. Please enter a whole number.")
+			return nil, false
+		}
+		scores[i] = &score
+	}
+
+	var players []entities.MatchPlayer
+	for i, side := range sides {
+		outcome := entities.OutcomeLoss
+		switch {
+		case draw:
+			outcome = entities.OutcomeDraw
+		case i+1 == winner:
+			outcome = entities.OutcomeWin
 		}
-	default:
-		fmt.Println("Invalid input. Please enter 'W' or 'L'.")
+		for _, participant := range side {
+			players = append(players, entities.MatchPlayer{UserID: participant.UserId, Side: i + 1, Outcome: outcome, Score: scores[i]})
+		}
+	}
+	return players, true
+}
+
+// readMatchSides asks which side every player was on when more than two players played in teams, so a doubles
+// match can be entered as 1, 2, 1, 2. Sides are numbered in the order they are first named. Otherwise every player
+// plays on their own side.
+func (ui *UI) readMatchSides(participants []models.MatchParticipant) ([][]models.MatchParticipant, bool) {
+	individual := make([][]models.MatchParticipant, len(participants))
+	for i, participant := range participants {
+		individual[i] = []models.MatchParticipant{participant}
+	}
+	if len(participants) <= 2 {
+		return individual, true
+	}
+
+	fmt.Print("Did the players play in teams? (y/n): ")
+	input, _ := ui.reader.ReadString('\n')
+	if strings.ToLower(strings.TrimSpace(input)) != "y" {
+		return individual, true
+	}
+
+	fmt.Println("Enter the side of every player, e.g. 1 for everyone on the first team and 2 for the second.")
+	var sides [][]models.MatchParticipant
+	sideIndex := make(map[int]int)
+	for _, participant := range participants {
+		fmt.Printf("Side of %s: ", participant.Username)
+		input, _ = ui.reader.ReadString('\n')
+		side, err := strconv.Atoi(strings.TrimSpace(input))
+		if err != nil || side < 1 || side > len(participants) {
+			fmt.Println("Invalid side. Please enter a valid number.")
+			return nil, false
+		}
+		index, ok := sideIndex[side]
+		if !ok {
+			index = len(sides)
+			sideIndex[side] = index
+			sides = append(sides, nil)
+		}
+		sides[index] = append(sides[index], participant)
+	}
+
+	if len(sides) < 2 {
+		fmt.Println("The players must be on at least two sides.")
+		return nil, false
 	}
+	return sides, true
 }
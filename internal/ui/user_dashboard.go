@@ -15,14 +15,19 @@ func (ui *UI) ShowUserDashboard() {
 
 		fmt.Println("Please choose an option:")
 		fmt.Println("1. Game Room")
-		fmt.Println("2. View Pending Invites")
-		fmt.Println("3. View Leaderboard")
-		fmt.Println("4. Update Results")
-		fmt.Println("5. View Upcoming Bookings")
-		fmt.Println("6. View Profile")
-		fmt.Println("7. Logout")
+		fmt.Println("2. Quick Play")
+		fmt.Println("3. Availability Grid")
+		fmt.Println("4. View Pending Invites")
+		fmt.Println("5. View Leaderboard")
+		fmt.Println("6. Update Results")
+		fmt.Println("7. View Upcoming Bookings")
+		fmt.Println("8. Check In")
+		fmt.Println("9. Recurring Bookings")
+		fmt.Println("10. Teams")
+		fmt.Println("11. View Profile")
+		fmt.Println("12. Logout")
 
-		fmt.Print("Enter your choice (1-7): ")
+		fmt.Print("Enter your choice (1-12): ")
 		choice, err := ui.reader.ReadString('\n')
 		if err != nil {
 			fmt.Println("Error reading input:", err)
@@ -35,21 +40,31 @@ func (ui *UI) ShowUserDashboard() {
 		case "1":
 			ui.ShowGameRoom()
 		case "2":
-			ui.ViewPendingInvites()
+			ui.QuickPlay()
 		case "3":
-			ui.ViewLeaderboard()
+			ui.ViewAvailabilityGrid()
 		case "4":
-			ui.UpdateResults()
+			ui.ViewPendingInvites()
 		case "5":
-			ui.ViewUpcomingBookings()
+			ui.ViewLeaderboard()
 		case "6":
-			ui.ViewProfile()
+			ui.UpdateResults()
 		case "7":
+			ui.ViewUpcomingBookings()
+		case "8":
+			ui.CheckIn()
+		case "9":
+			ui.ViewRecurringBookings()
+		case "10":
+			ui.ViewTeams()
+		case "11":
+			ui.ViewProfile()
+		case "12":
 			fmt.Println("Logging out...")
 			return
 
 		default:
-			fmt.Println("Invalid choice. Please enter a number between 1 and 7.")
+			fmt.Println("Invalid choice. Please enter a number between 1 and 12.")
 		}
 	}
 }
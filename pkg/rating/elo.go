@@ -0,0 +1,23 @@
+package rating
+
+import "math"
+
+// Elo moves the rating by KFactor times the difference between the actual and the expected score.
+// With several opponents the difference is averaged, so a match always counts as a single game.
+type Elo struct {
+	KFactor float64
+}
+
+func (e Elo) Update(player Rating, results []Result) Rating {
+	if len(results) == 0 {
+		return player
+	}
+
+	var difference float64
+	for _, result := range results {
+		expected := 1 / (1 + math.Pow(10, (result.Opponent.Value-player.Value)/400))
+		difference += result.Score - expected
+	}
+	player.Value += e.KFactor * difference / float64(len(results))
+	return player
+}
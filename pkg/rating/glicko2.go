@@ -0,0 +1,89 @@
+package rating
+
+import "math"
+
+// glicko2Scale converts ratings and deviations between the Glicko and the Glicko-2 scale
+const glicko2Scale = 173.7178
+
+// glicko2Tolerance is the convergence tolerance of the volatility iteration
+const glicko2Tolerance = 0.000001
+
+// Glicko2 implements Mark Glickman's Glicko-2 system, treating every match as one rating period.
+// Tau constrains how much the volatility can change; reasonable values are between 0.3 and 1.2.
+type Glicko2 struct {
+	Tau float64
+}
+
+func (g Glicko2) Update(player Rating, results []Result) Rating {
+	mu := (player.Value - 1500) / glicko2Scale
+	phi := player.Deviation / glicko2Scale
+	sigma := player.Volatility
+
+	// A player who did not play only becomes less certain
+	if len(results) == 0 {
+		player.Deviation = math.Sqrt(phi*phi+sigma*sigma) * glicko2Scale
+		return player
+	}
+
+	var inverseVariance, improvement float64
+	for _, result := range results {
+		opponentMu := (result.Opponent.Value - 1500) / glicko2Scale
+		weight := glickoWeight(result.Opponent.Deviation / glicko2Scale)
+		expected := 1 / (1 + math.Exp(-weight*(mu-opponentMu)))
+		inverseVariance += weight * weight * expected * (1 - expected)
+		improvement += weight * (result.Score - expected)
+	}
+	variance := 1 / inverseVariance
+	delta := variance * improvement
+
+	sigma = g.volatility(phi, sigma, variance, delta)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/variance)
+	mu += phi * phi * improvement
+
+	return Rating{
+		Value:      mu*glicko2Scale + 1500,
+		Deviation:  phi * glicko2Scale,
+		Volatility: sigma,
+	}
+}
+
+// volatility finds the new volatility with the Illinois algorithm, as described in step 5 of the Glicko-2 paper
+func (g Glicko2) volatility(phi, sigma, variance, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + variance + ex
+		return ex*(delta*delta-d)/(2*d*d) - (x-a)/(g.Tau*g.Tau)
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+variance {
+		upper = math.Log(delta*delta - phi*phi - variance)
+	} else {
+		k := 1.0
+		for f(a-k*g.Tau) < 0 {
+			k++
+		}
+		upper = a - k*g.Tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > glicko2Tolerance {
+		next := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fNext := f(next)
+		if fNext*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = next, fNext
+	}
+	return math.Exp(lower / 2)
+}
+
+// glickoWeight reduces the impact of a result against an opponent whose rating is uncertain
+func glickoWeight(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
@@ -0,0 +1,41 @@
+// Package rating implements the systems used to rate the players of a game from their match results
+package rating
+
+import "fmt"
+
+// Names of the supported rating systems
+const (
+	SystemElo     = "elo"
+	SystemGlicko2 = "glicko2"
+)
+
+// Rating is a player's strength in a game. Deviation and Volatility are only used by Glicko-2
+// and are carried over unchanged by Elo.
+type Rating struct {
+	Value      float64
+	Deviation  float64
+	Volatility float64
+}
+
+// Result is the outcome of a game against one opponent, scored 1 for a win, 0.5 for a draw and 0 for a loss
+type Result struct {
+	Opponent Rating
+	Score    float64
+}
+
+// System computes a player's new rating from the results of one match
+type System interface {
+	Update(player Rating, results []Result) Rating
+}
+
+// New returns the rating system with the given name
+func New(name string, eloKFactor, glickoTau float64) (System, error) {
+	switch name {
+	case SystemElo:
+		return Elo{KFactor: eloKFactor}, nil
+	case SystemGlicko2:
+		return Glicko2{Tau: glickoTau}, nil
+	default:
+		return nil, fmt.Errorf("unknown rating system %q", name)
+	}
+}
@@ -0,0 +1,96 @@
+package scoring
+
+import (
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"sort"
+)
+
+// Ladder ranks players by their position on a ladder. Newcomers join at the bottom and a winner who was placed
+// below a loser of the match takes the place of the highest such loser, moving everyone in between down a rung.
+// Draws leave the ladder unchanged. The score of a player is their rung counted from the bottom, so the top of a
+// ladder of n players scores n.
+type Ladder struct{}
+
+func (Ladder) Update(standings []*entities.Leaderboard, match []entities.MatchPlayer) {
+	// Players who have not been placed yet score 0 and so start at the bottom
+	ladder := make([]*entities.Leaderboard, len(standings))
+	copy(ladder, standings)
+	sort.SliceStable(ladder, func(i, j int) bool { return ladder[i].Score > ladder[j].Score })
+
+	ladder = climb(ladder, match)
+	assignRungs(ladder)
+}
+
+func (Ladder) Recompute(standings []*entities.Leaderboard, history [][]entities.MatchPlayer) {
+	byUser := make(map[uuid.UUID]*entities.Leaderboard, len(standings))
+	for _, standing := range standings {
+		byUser[standing.UserID] = standing
+	}
+
+	var ladder []*entities.Leaderboard
+	placed := make(map[uuid.UUID]bool, len(standings))
+	for _, match := range history {
+		for _, player := range match {
+			if standing, ok := byUser[player.UserID]; ok && !placed[player.UserID] {
+				ladder = append(ladder, standing)
+				placed[player.UserID] = true
+			}
+		}
+		ladder = climb(ladder, match)
+	}
+
+	// Players whose results predate the recorded matches go below everyone else, in the order they were given
+	for _, standing := range standings {
+		if !placed[standing.UserID] {
+			ladder = append(ladder, standing)
+		}
+	}
+	assignRungs(ladder)
+}
+
+// climb moves every winner of the match above the highest placed loser who was above them
+func climb(ladder []*entities.Leaderboard, match []entities.MatchPlayer) []*entities.Leaderboard {
+	for _, winner := range match {
+		if winner.Outcome != entities.OutcomeWin {
+			continue
+		}
+		from := position(ladder, winner.UserID)
+		if from < 0 {
+			continue
+		}
+
+		to := from
+		for _, loser := range match {
+			if loser.Outcome != entities.OutcomeLoss {
+				continue
+			}
+			if at := position(ladder, loser.UserID); at >= 0 && at < to {
+				to = at
+			}
+		}
+		if to == from {
+			continue
+		}
+
+		standing := ladder[from]
+		copy(ladder[to+1:from+1], ladder[to:from])
+		ladder[to] = standing
+	}
+	return ladder
+}
+
+func position(ladder []*entities.Leaderboard, userID uuid.UUID) int {
+	for i, standing := range ladder {
+		if standing.UserID == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+func assignRungs(ladder []*entities.Leaderboard) {
+	for i, standing := range ladder {
+		standing.Score = float64(len(ladder) - i)
+	}
+}
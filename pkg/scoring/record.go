@@ -0,0 +1,46 @@
+package scoring
+
+import (
+	"project2/internal/domain/entities"
+	"project2/pkg/utils"
+)
+
+// recordStrategy scores every player from their own record alone, so a match only changes the scores of its players
+type recordStrategy func(standing *entities.Leaderboard) float64
+
+func (score recordStrategy) Update(standings []*entities.Leaderboard, match []entities.MatchPlayer) {
+	for _, standing := range standings {
+		if playedIn(standing, match) {
+			standing.Score = score(standing)
+		}
+	}
+}
+
+func (score recordStrategy) Recompute(standings []*entities.Leaderboard, _ [][]entities.MatchPlayer) {
+	for _, standing := range standings {
+		standing.Score = score(standing)
+	}
+}
+
+// winRatioScore is the legacy formula that every game used before strategies could be chosen
+func winRatioScore(standing *entities.Leaderboard) float64 {
+	return float64(utils.GetTotalScoreWithDraws(standing.Wins, standing.Draws, standing.Losses))
+}
+
+// ratingScore ranks players by their rating
+func ratingScore(standing *entities.Leaderboard) float64 {
+	return standing.Rating
+}
+
+func (p Points) score(standing *entities.Leaderboard) float64 {
+	return p.Win*float64(standing.Wins) + p.Draw*float64(standing.Draws) + p.Loss*float64(standing.Losses)
+}
+
+func playedIn(standing *entities.Leaderboard, match []entities.MatchPlayer) bool {
+	for _, player := range match {
+		if player.UserID == standing.UserID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// Package scoring implements the strategies that turn the records of a game's players into the scores its
+// leaderboard is ranked by, highest first
+package scoring
+
+import (
+	"fmt"
+	"project2/internal/domain/entities"
+)
+
+// Names of the supported scoring strategies
+const (
+	StrategyWinRatio = "win_ratio"
+	StrategyRating   = "rating"
+	StrategyPoints   = "points"
+	StrategyLadder   = "ladder"
+)
+
+// Strategies lists the supported strategies in the order they are offered to admins
+var Strategies = []string{StrategyWinRatio, StrategyRating, StrategyPoints, StrategyLadder}
+
+// Strategy scores the standings of a game. Standings always hold every player of the game, with their wins,
+// losses, draws and rating already counting the matches given.
+type Strategy interface {
+	// Update rescores the standings after a confirmed match between the given players
+	Update(standings []*entities.Leaderboard, match []entities.MatchPlayer)
+	// Recompute rescores the standings from scratch, given the confirmed matches of the game in the order they were played
+	Recompute(standings []*entities.Leaderboard, history [][]entities.MatchPlayer)
+}
+
+// Points is what a win, a draw and a loss are worth under the points strategy
+type Points struct {
+	Win  float64
+	Draw float64
+	Loss float64
+}
+
+// New returns the strategy with the given name
+func New(name string, points Points) (Strategy, error) {
+	switch name {
+	case StrategyWinRatio:
+		return recordStrategy(winRatioScore), nil
+	case StrategyRating:
+		return recordStrategy(ratingScore), nil
+	case StrategyPoints:
+		return recordStrategy(points.score), nil
+	case StrategyLadder:
+		return Ladder{}, nil
+	default:
+		return nil, fmt.Errorf("unknown scoring strategy %q", name)
+	}
+}
+
+// IsValid reports whether name is a supported strategy
+func IsValid(name string) bool {
+	for _, strategy := range Strategies {
+		if strategy == name {
+			return true
+		}
+	}
+	return false
+}
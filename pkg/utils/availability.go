@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"sort"
+	"time"
+)
+
+// BuildAvailabilityGrid arranges the slots of a day into a time × game matrix. Each cell shows the free seats
+// of all the tables of the game at that time out of their capacity (e.g. "2/4"), "FULL" when no seat is left,
+// "PAST" once the slots have started and "-" when the game has no slot at that time.
+// Games without any slot that day are left out.
+func BuildAvailabilityGrid(games []entities.Game, slots []models.SlotOccupancy, now time.Time) models.AvailabilityGrid {
+	type cell struct {
+		free, capacity int
+	}
+	cells := make(map[time.Time]map[uuid.UUID]*cell)
+	hasSlots := make(map[uuid.UUID]bool)
+	var startTimes []time.Time
+
+	listed := make(map[uuid.UUID]bool)
+	for _, game := range games {
+		listed[game.GameID] = true
+	}
+
+	for _, slot := range slots {
+		if !listed[slot.GameID] {
+			continue
+		}
+		start := slot.StartTime.UTC()
+		if cells[start] == nil {
+			cells[start] = make(map[uuid.UUID]*cell)
+			startTimes = append(startTimes, start)
+		}
+		if cells[start][slot.GameID] == nil {
+			cells[start][slot.GameID] = &cell{}
+		}
+
+		free := slot.Capacity - slot.BookedCount
+		if slot.IsFull() {
+			free = 0
+		}
+		cells[start][slot.GameID].free += free
+		cells[start][slot.GameID].capacity += slot.Capacity
+		hasSlots[slot.GameID] = true
+	}
+	sort.Slice(startTimes, func(i, j int) bool { return startTimes[i].Before(startTimes[j]) })
+
+	var grid models.AvailabilityGrid
+	var columns []uuid.UUID
+	for _, game := range games {
+		if hasSlots[game.GameID] {
+			columns = append(columns, game.GameID)
+			grid.Games = append(grid.Games, game.GameName)
+		}
+	}
+
+	for _, start := range startTimes {
+		row := models.AvailabilityRow{StartTime: start}
+		for _, gameID := range columns {
+			c := cells[start][gameID]
+			switch {
+			case c == nil:
+				row.Cells = append(row.Cells, "-")
+			case !start.After(now):
+				row.Cells = append(row.Cells, "PAST")
+			case c.free == 0:
+				row.Cells = append(row.Cells, "FULL")
+			default:
+				row.Cells = append(row.Cells, fmt.Sprintf("%d/%d", c.free, c.capacity))
+			}
+		}
+		grid.Rows = append(grid.Rows, row)
+	}
+	return grid
+}
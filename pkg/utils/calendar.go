@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	"strings"
+	"time"
+)
+
+// IsWorkingDay reports whether the game room is open on the given weekday.
+func IsWorkingDay(weekday time.Weekday) bool {
+	for _, workingDay := range config.WorkingDays {
+		if workingDay == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseHolidayCalendar reads the holidays from a calendar in the given format: "csv", "yaml" or "ics".
+//
+// CSV files hold one "YYYY-MM-DD,Name" row per holiday, with an optional header row.
+// YAML files hold a list of entries with "date" and "name" keys.
+// ICS files are read for the summary of every event, which is a holiday on every day from its start date up to,
+// but not including, its end date.
+func ParseHolidayCalendar(r io.Reader, format string) ([]entities.Holiday, error) {
+	switch strings.ToLower(strings.TrimPrefix(format, ".")) {
+	case "csv":
+		return parseCSVCalendar(r)
+	case "yaml", "yml":
+		return parseYAMLCalendar(r)
+	case "ics":
+		return parseICSCalendar(r)
+	default:
+		return nil, fmt.Errorf("unsupported calendar format %q", format)
+	}
+}
+
+func parseCSVCalendar(r io.Reader) ([]entities.Holiday, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv calendar: %w", err)
+	}
+
+	var holidays []entities.Holiday
+	for i, record := range records {
+		if len(record) == 0 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(record[0]))
+		if err != nil {
+			// The first row may be a header
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("invalid date %q on line %d", record[0], i+1)
+		}
+		holiday := entities.Holiday{Date: date}
+		if len(record) > 1 {
+			holiday.Name = strings.TrimSpace(record[1])
+		}
+		holidays = append(holidays, holiday)
+	}
+	return holidays, nil
+}
+
+// yamlHoliday is a single entry of a YAML calendar
+type yamlHoliday struct {
+	Date string `yaml:"date"`
+	Name string `yaml:"name"`
+}
+
+func parseYAMLCalendar(r io.Reader) ([]entities.Holiday, error) {
+	var document yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid yaml calendar: %w", err)
+	}
+
+	// The entries are either the document itself or listed under a "holidays" key
+	var entries []yamlHoliday
+	root := document.Content[0]
+	if root.Kind == yaml.MappingNode {
+		var calendar struct {
+			Holidays []yamlHoliday `yaml:"holidays"`
+		}
+		if err := root.Decode(&calendar); err != nil {
+			return nil, fmt.Errorf("invalid yaml calendar: %w", err)
+		}
+		if calendar.Holidays == nil {
+			return nil, errors.New("the yaml calendar needs a list of holidays")
+		}
+		entries = calendar.Holidays
+	} else if err := root.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("invalid yaml calendar: %w", err)
+	}
+
+	holidays := make([]entities.Holiday, 0, len(entries))
+	for i, entry := range entries {
+		if strings.TrimSpace(entry.Date) == "" {
+			return nil, fmt.Errorf("holiday %d of the yaml calendar needs a date", i+1)
+		}
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(entry.Date))
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q for holiday %d", entry.Date, i+1)
+		}
+		holidays = append(holidays, entities.Holiday{Date: date, Name: strings.TrimSpace(entry.Name)})
+	}
+	return holidays, nil
+}
+
+func parseICSCalendar(r io.Reader) ([]entities.Holiday, error) {
+	var holidays []entities.Holiday
+	var current *entities.Holiday
+	var end time.Time
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		name, value, found := strings.Cut(text, ":")
+		if !found {
+			continue
+		}
+		// Drop parameters such as ";VALUE=DATE"
+		name, _, _ = strings.Cut(name, ";")
+
+		switch {
+		case text == "BEGIN:VEVENT":
+			current, end = &entities.Holiday{}, time.Time{}
+		case text == "END:VEVENT" && current != nil:
+			if current.Date.IsZero() {
+				return nil, errors.New("calendar event without a start date")
+			}
+			holidays = append(holidays, *current)
+			for date := current.Date.AddDate(0, 0, 1); date.Before(end); date = date.AddDate(0, 0, 1) {
+				holidays = append(holidays, entities.Holiday{Date: date, Name: current.Name})
+			}
+			current = nil
+		case name == "DTSTART" && current != nil:
+			date, err := parseICSDate(value)
+			if err != nil {
+				return nil, err
+			}
+			current.Date = date
+		case name == "DTEND" && current != nil:
+			date, err := parseICSDate(value)
+			if err != nil {
+				return nil, err
+			}
+			end = date
+		case name == "SUMMARY" && current != nil:
+			current.Name = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ics calendar: %w", err)
+	}
+	return holidays, nil
+}
+
+// parseICSDate reads the day of an ICS date or date-time value
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid event date %q", value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid event date %q", value)
+	}
+	return date, nil
+}
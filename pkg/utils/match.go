@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"fmt"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"sort"
+	"strings"
+)
+
+// FormatMatchResult describes how a match ended, naming the winning side first, e.g. "alice beat bob 21–18",
+// "alice & carol beat bob & dave" or "alice drew with bob 2–2". Scores are only shown when every side has one.
+func FormatMatchResult(players []models.MatchPlayerResult) string {
+	type side struct {
+		names   []string
+		outcome string
+		score   *int
+	}
+	var sides []*side
+	bySide := make(map[int]*side)
+	for _, player := range players {
+		s, ok := bySide[player.Side]
+		if !ok {
+			s = &side{outcome: player.Outcome, score: player.Score}
+			bySide[player.Side] = s
+			sides = append(sides, s)
+		}
+		s.names = append(s.names, player.Username)
+	}
+	if len(sides) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(sides, func(i, j int) bool {
+		return sides[i].outcome == entities.OutcomeWin && sides[j].outcome != entities.OutcomeWin
+	})
+
+	names := make([]string, len(sides))
+	scores := make([]string, len(sides))
+	hasScores := true
+	for i, s := range sides {
+		names[i] = strings.Join(s.names, " & ")
+		if s.score == nil {
+			hasScores = false
+			continue
+		}
+		scores[i] = fmt.Sprint(*s.score)
+	}
+
+	verb := "beat"
+	if sides[0].outcome == entities.OutcomeDraw {
+		verb = "drew with"
+	}
+	description := names[0]
+	if len(names) > 1 {
+		description = fmt.Sprintf("%s %s %s", names[0], verb, strings.Join(names[1:], ", "))
+	}
+	if hasScores {
+		description = fmt.Sprintf("%s %s", description, strings.Join(scores, "–"))
+	}
+	return description
+}
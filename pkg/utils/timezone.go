@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"project2/internal/config"
+	"time"
+)
+
+// Now returns the current time in the configured time zone.
+// It is a variable so that tests can move the clock, e.g. to just before midnight.
+var Now = func() time.Time {
+	return time.Now().In(config.Location())
+}
+
+// StartOfDay returns the midnight that starts the day of t in the configured time zone.
+// Days are built with time.Date, so a day across a DST change is 23 or 25 hours long.
+func StartOfDay(t time.Time) time.Time {
+	t = t.In(config.Location())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// IsSameDay reports whether a and b fall on the same day in the configured time zone.
+func IsSameDay(a, b time.Time) bool {
+	return StartOfDay(a).Equal(StartOfDay(b))
+}
+
+// FormatDay formats the day of t in the configured time zone, e.g. "Mon, 02 Jan".
+func FormatDay(t time.Time) string {
+	return t.In(config.Location()).Format("Mon, 02 Jan")
+}
+
+// FormatClock formats the time of day of t in the configured time zone, e.g. "03:04 PM".
+func FormatClock(t time.Time) string {
+	return t.In(config.Location()).Format("03:04 PM")
+}
+
+// ZoneName returns the abbreviation of the configured time zone at t, e.g. "IST".
+func ZoneName(t time.Time) string {
+	name, _ := t.In(config.Location()).Zone()
+	return name
+}
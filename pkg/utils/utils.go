@@ -5,24 +5,31 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
-	"log"
 	"math"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
 	repository_interfaces "project2/internal/domain/interfaces/repository"
+	"strings"
 	"time"
 )
 
 func GetTotalScore(totalWins, totalLosses int) float32 {
-	totalGames := totalWins + totalLosses
-	return calculateScore(totalWins, totalLosses, totalGames)
+	return GetTotalScoreWithDraws(totalWins, 0, totalLosses)
 }
 
-func calculateScore(totalWins, totalLosses, totalGames int) float32 {
+// GetTotalScoreWithDraws scores a record that includes draws, each of which counts as half a win and half a loss
+func GetTotalScoreWithDraws(totalWins, totalDraws, totalLosses int) float32 {
+	totalGames := totalWins + totalDraws + totalLosses
+	halfDraws := float32(totalDraws) / 2
+	return calculateScore(float32(totalWins)+halfDraws, float32(totalLosses)+halfDraws, totalGames)
+}
+
+func calculateScore(totalWins, totalLosses float32, totalGames int) float32 {
 	var winLossRatio float32
 	if totalLosses == 0 {
-		winLossRatio = float32(totalWins)
+		winLossRatio = totalWins
 	} else {
-		winLossRatio = float32(totalWins) / float32(totalLosses)
+		winLossRatio = totalWins / totalLosses
 	}
 
 	var gameFactor float32 = float32(1) + float32(math.Sqrt(float64(totalGames)))
@@ -43,53 +50,187 @@ func GetNameFromEmail(email string) string {
 	return name.String()
 }
 
-func InsertAllSlots(ctx context.Context, slotRepo repository_interfaces.SlotRepository, gameRepo repository_interfaces.GameRepository) error {
-	today := time.Now().Truncate(24 * time.Hour)
-	location, err := time.LoadLocation("Asia/Kolkata")
-	if err != nil {
-		log.Fatalf("Failed to load location: %v", err)
+// GetGameSchedule returns the schedule of the game on the given weekday.
+// Weekday overrides take precedence over the game's own hours, which fall back to the configured defaults.
+func GetGameSchedule(game entities.Game, weekday time.Weekday) entities.GameSchedule {
+	if schedule, ok := game.WeekdaySchedules[weekday]; ok {
+		return schedule
 	}
 
+	schedule := entities.GameSchedule{
+		OpenTime:     game.OpenTime,
+		CloseTime:    game.CloseTime,
+		SlotDuration: game.SlotDuration,
+	}
+	if schedule.OpenTime == "" {
+		schedule.OpenTime = config.DefaultOpenTime
+	}
+	if schedule.CloseTime == "" {
+		schedule.CloseTime = config.DefaultCloseTime
+	}
+	if schedule.SlotDuration <= 0 {
+		schedule.SlotDuration = config.DefaultSlotDuration
+	}
+	return schedule
+}
+
+// ParseWeekday parses a weekday name, accepting any prefix of at least three letters (e.g. "tue", "Tuesday").
+func ParseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if len(name) >= 3 && strings.HasPrefix(strings.ToLower(weekday.String()), name) {
+			return weekday, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid weekday %q", name)
+}
+
+// ParseWeekdays parses a comma separated list of weekday names, ignoring duplicates.
+func ParseWeekdays(list string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	seen := make(map[time.Weekday]bool)
+	for _, name := range strings.Split(list, ",") {
+		weekday, err := ParseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[weekday] {
+			seen[weekday] = true
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	return weekdays, nil
+}
+
+// InsertAllSlots creates the slots of every game for each day of the booking horizon and returns the created slots.
+// Non-working days and holidays are skipped, no slot is created inside a blackout window and slots that already
+// exist are kept, so that a run only fills in the slots missing from the horizon.
+func InsertAllSlots(ctx context.Context, slotRepo repository_interfaces.SlotRepository, gameRepo repository_interfaces.GameRepository, blackoutRepo repository_interfaces.BlackoutRepository, holidayRepo repository_interfaces.HolidayRepository) ([]entities.Slot, error) {
+	location := config.Location()
+
 	// Fetch all games
 	games, err := gameRepo.FetchAllGames(ctx)
 	if err != nil {
-		return fmt.Errorf("error fetching games: %w", err)
+		return nil, fmt.Errorf("error fetching games: %w", err)
 	}
 
-	now := time.Now().In(location)
-	startTime := time.Date(now.Year(), now.Month(), now.Day(), 9, 0, 0, 0, location)
-	endTime := time.Date(now.Year(), now.Month(), now.Day(), 18, 0, 0, 0, location)
+	// Days start at midnight in the configured time zone, whatever the zone of the server
+	today := StartOfDay(Now())
 
-	for _, game := range games {
-		// Check for existing slots for this game on today's date
-		existingSlots, err := slotRepo.FetchSlotsByGameIDAndDate(ctx, game.GameID, today)
-		if err != nil {
-			return fmt.Errorf("error checking existing slots for game %s: %w", game.GameName, err)
+	// Fetch the blackouts of the whole horizon
+	blackouts, err := blackoutRepo.FetchBlackoutsBetween(ctx, today, today.AddDate(0, 0, config.BookingHorizonDays))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching blackouts: %w", err)
+	}
+
+	holidays, err := holidayRepo.FetchHolidaysBetween(ctx, today, today.AddDate(0, 0, config.BookingHorizonDays-1))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching holidays: %w", err)
+	}
+	closedDates := make(map[string]bool)
+	for _, holiday := range holidays {
+		closedDates[holiday.Date.Format("2006-01-02")] = true
+	}
+
+	var createdSlots []entities.Slot
+	for day := 0; day < config.BookingHorizonDays; day++ {
+		date := today.AddDate(0, 0, day)
+		if !IsWorkingDay(date.Weekday()) || closedDates[date.Format("2006-01-02")] {
+			continue
+		}
+		for _, game := range games {
+			slots, err := insertGameSlotsForDate(ctx, slotRepo, game, date, location, blackouts)
+			if err != nil {
+				return createdSlots, err
+			}
+			createdSlots = append(createdSlots, slots...)
 		}
+	}
+	return createdSlots, nil
+}
+
+// insertGameSlotsForDate creates the slots of a single game on the given date
+func insertGameSlotsForDate(ctx context.Context, slotRepo repository_interfaces.SlotRepository, game entities.Game, date time.Time, location *time.Location, blackouts []entities.Blackout) ([]entities.Slot, error) {
+	// The day may already be partly generated, e.g. after a blackout was lifted or the schedule changed
+	existingSlots, err := slotRepo.FetchSlotsByGameIDAndDate(ctx, game.GameID, date)
+	if err != nil {
+		return nil, fmt.Errorf("error checking existing slots for game %s: %w", game.GameName, err)
+	}
 
-		// If no slots exist, create new slots
-		if len(existingSlots) == 0 {
-			for current := startTime; current.Before(endTime); current = current.Add(20 * time.Minute) {
-				slotEndTime := current.Add(20 * time.Minute)
-				if slotEndTime.After(endTime) {
-					slotEndTime = endTime
-				}
-
-				newSlot := &entities.Slot{
-					SlotID:    uuid.New(),
-					GameID:    game.GameID,
-					Date:      today,
-					StartTime: current,
-					EndTime:   slotEndTime,
-					IsBooked:  false,
-				}
-
-				// Insert the new slot
-				if _, err := slotRepo.CreateSlot(ctx, newSlot); err != nil {
-					return fmt.Errorf("error inserting slot for game %s: %w", game.GameName, err)
-				}
+	schedule := GetGameSchedule(game, date.Weekday())
+	openTime, err := time.Parse("15:04", schedule.OpenTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid opening time for game %s: %w", game.GameName, err)
+	}
+	closeTime, err := time.Parse("15:04", schedule.CloseTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid closing time for game %s: %w", game.GameName, err)
+	}
+	slotDuration := time.Duration(schedule.SlotDuration) * time.Minute
+
+	// Every instance of the game (e.g. each table) gets its own independently bookable slot
+	instances := game.Instances
+	if instances < 1 {
+		instances = 1
+	}
+
+	startTime := time.Date(date.Year(), date.Month(), date.Day(), openTime.Hour(), openTime.Minute(), 0, 0, location)
+	endTime := time.Date(date.Year(), date.Month(), date.Day(), closeTime.Hour(), closeTime.Minute(), 0, 0, location)
+
+	// Only full-length slots are created, a leftover shorter than the slot duration is dropped
+	var slots []entities.Slot
+	for current := startTime; !current.Add(slotDuration).After(endTime); current = current.Add(slotDuration) {
+		slotEndTime := current.Add(slotDuration)
+		if isBlackedOut(blackouts, game.GameID, current, slotEndTime) {
+			continue
+		}
+
+		for instance := 1; instance <= instances; instance++ {
+			// Slots that are kept from an earlier schedule are not overlapped by the new ones
+			if overlapsSlot(existingSlots, instance, current, slotEndTime) {
+				continue
+			}
+
+			newSlot := &entities.Slot{
+				GameID:    game.GameID,
+				Instance:  instance,
+				Date:      date,
+				StartTime: current,
+				EndTime:   slotEndTime,
+				IsBooked:  false,
 			}
+
+			// Insert the new slot, skipping it if another generation run created it in the meantime
+			id, err := slotRepo.CreateSlot(ctx, newSlot)
+			if err != nil {
+				return slots, fmt.Errorf("error inserting slot for game %s: %w", game.GameName, err)
+			}
+			if id == uuid.Nil {
+				continue
+			}
+			newSlot.SlotID = id
+			slots = append(slots, *newSlot)
+		}
+	}
+	return slots, nil
+}
+
+// overlapsSlot reports whether any of the slots of the given instance overlaps the time between start and end
+func overlapsSlot(slots []entities.Slot, instance int, start, end time.Time) bool {
+	for _, slot := range slots {
+		if slot.Instance == instance && slot.StartTime.Before(end) && slot.EndTime.After(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBlackedOut reports whether any of the blackouts closes the game between start and end
+func isBlackedOut(blackouts []entities.Blackout, gameID uuid.UUID, start, end time.Time) bool {
+	for _, blackout := range blackouts {
+		if blackout.Covers(gameID, start, end) {
+			return true
 		}
 	}
-	return nil
+	return false
 }
@@ -3,6 +3,7 @@ package validation
 import (
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -44,3 +45,9 @@ func IsValidGender(gender string) bool {
 	gender = strings.ToLower(gender)
 	return gender == "male" || gender == "female" || gender == "other"
 }
+
+// checks if the time is of the format HH:MM in 24-hour clock
+func IsValidTimeOfDay(value string) bool {
+	_, err := time.Parse("15:04", value)
+	return err == nil && len(value) == 5
+}
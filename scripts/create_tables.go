@@ -48,6 +48,12 @@ func InitializeTables() {
 			updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		);`,
 
+		`ALTER TABLE games
+			ADD COLUMN IF NOT EXISTS open_time VARCHAR(5) NOT NULL DEFAULT '09:00',
+			ADD COLUMN IF NOT EXISTS close_time VARCHAR(5) NOT NULL DEFAULT '18:00',
+			ADD COLUMN IF NOT EXISTS slot_duration INT NOT NULL DEFAULT 20,
+			ADD COLUMN IF NOT EXISTS weekday_schedules JSONB;`,
+
 		`CREATE TABLE IF NOT EXISTS slots (
 			slot_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 			game_id UUID REFERENCES games(game_id) ON DELETE CASCADE,
@@ -58,6 +64,10 @@ func InitializeTables() {
 			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		);`,
 
+		`ALTER TABLE slots ADD COLUMN IF NOT EXISTS instance INT NOT NULL DEFAULT 1;`,
+
+		`CREATE UNIQUE INDEX IF NOT EXISTS slots_game_start_instance_key ON slots (game_id, start_time, instance);`,
+
 		`CREATE TABLE IF NOT EXISTS bookings (
 			booking_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 			slot_id UUID REFERENCES slots(slot_id) ON DELETE CASCADE,
@@ -66,6 +76,53 @@ func InitializeTables() {
 			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		);`,
 
+		`CREATE UNIQUE INDEX IF NOT EXISTS bookings_slot_user_key ON bookings (slot_id, user_id);`,
+
+		`ALTER TABLE bookings
+			ADD COLUMN IF NOT EXISTS check_in_code VARCHAR(6) NOT NULL DEFAULT lpad(floor(random() * 1000000)::int::text, 6, '0'),
+			ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ;`,
+
+		// Bookings made before attendance was tracked are added as attended, so that they are never
+		// taken for no-shows. New bookings wait for their check-in.
+		`ALTER TABLE bookings ADD COLUMN IF NOT EXISTS attendance VARCHAR(10) NOT NULL DEFAULT 'attended'
+			CHECK (attendance IN ('pending', 'checked_in', 'no_show', 'attended'));`,
+
+		`ALTER TABLE bookings ALTER COLUMN attendance SET DEFAULT 'pending';`,
+
+		`CREATE TABLE IF NOT EXISTS waitlist (
+			waitlist_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			slot_id UUID REFERENCES slots(slot_id) ON DELETE CASCADE,
+			user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (slot_id, user_id)
+		);`,
+
+		`CREATE TABLE IF NOT EXISTS recurring_bookings (
+			recurrence_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+			game_id UUID REFERENCES games(game_id) ON DELETE CASCADE,
+			weekdays INT[] NOT NULL,
+			start_time VARCHAR(5) NOT NULL,
+			end_date DATE NOT NULL,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		);`,
+
+		`CREATE TABLE IF NOT EXISTS blackouts (
+			blackout_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			game_id UUID REFERENCES games(game_id) ON DELETE CASCADE,
+			start_time TIMESTAMPTZ NOT NULL,
+			end_time TIMESTAMPTZ NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+			CHECK (end_time > start_time)
+		);`,
+
+		`CREATE TABLE IF NOT EXISTS holidays (
+			holiday_date DATE PRIMARY KEY,
+			name VARCHAR(255) NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		);`,
+
 		`CREATE TABLE IF NOT EXISTS invitations (
 			invitation_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 			inviting_user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
@@ -92,6 +149,89 @@ func InitializeTables() {
 			score FLOAT DEFAULT 0.0,
 			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 		);`,
+
+		`CREATE TABLE IF NOT EXISTS matches (
+			match_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			slot_id UUID UNIQUE REFERENCES slots(slot_id) ON DELETE CASCADE,
+			reported_by UUID REFERENCES users(user_id) ON DELETE CASCADE,
+			status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'disputed')),
+			confirm_by TIMESTAMPTZ NOT NULL,
+			resolved_at TIMESTAMPTZ,
+			applied_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+		);`,
+
+		`CREATE TABLE IF NOT EXISTS match_players (
+			match_id UUID REFERENCES matches(match_id) ON DELETE CASCADE,
+			user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+			booking_id UUID REFERENCES bookings(booking_id) ON DELETE CASCADE,
+			side INT NOT NULL CHECK (side > 0),
+			outcome VARCHAR(5) NOT NULL CHECK (outcome IN ('win', 'loss', 'draw')),
+			score INT CHECK (score >= 0),
+			PRIMARY KEY (match_id, user_id)
+		);`,
+
+		`CREATE TABLE IF NOT EXISTS result_confirmations (
+			match_id UUID REFERENCES matches(match_id) ON DELETE CASCADE,
+			user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+			status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'disputed')),
+			responded_at TIMESTAMPTZ,
+			PRIMARY KEY (match_id, user_id)
+		);`,
+
+		`ALTER TABLE bookings
+			DROP CONSTRAINT IF EXISTS bookings_result_check,
+			ADD CONSTRAINT bookings_result_check CHECK (result IN ('win', 'loss', 'draw', 'pending'));`,
+
+		`ALTER TABLE leaderboard ADD COLUMN IF NOT EXISTS draws INT NOT NULL DEFAULT 0;`,
+
+		`ALTER TABLE leaderboard
+			ADD COLUMN IF NOT EXISTS rating FLOAT NOT NULL DEFAULT 1500,
+			ADD COLUMN IF NOT EXISTS rating_deviation FLOAT NOT NULL DEFAULT 350,
+			ADD COLUMN IF NOT EXISTS volatility FLOAT NOT NULL DEFAULT 0.06;`,
+
+		// Before the index exists, players recorded twice for the same game are merged into their oldest entry,
+		// which keeps its rating and takes on the record of the others
+		`DO $$ BEGIN
+			IF to_regclass('leaderboard_user_game_key') IS NULL THEN
+				UPDATE leaderboard l SET wins = d.wins, losses = d.losses, draws = d.draws
+				FROM (SELECT user_id, game_id, SUM(wins) AS wins, SUM(losses) AS losses, SUM(draws) AS draws
+				      FROM leaderboard GROUP BY user_id, game_id HAVING COUNT(*) > 1) d
+				WHERE l.user_id = d.user_id AND l.game_id = d.game_id;
+
+				DELETE FROM leaderboard l USING leaderboard k
+				WHERE k.user_id = l.user_id AND k.game_id = l.game_id
+				  AND (k.created_at, k.score_id) < (l.created_at, l.score_id);
+			END IF;
+		END $$;`,
+
+		`CREATE UNIQUE INDEX IF NOT EXISTS leaderboard_user_game_key ON leaderboard (user_id, game_id);`,
+
+		// Games that existed before strategies could be chosen keep the win ratio their scores were computed with
+		`ALTER TABLE games ADD COLUMN IF NOT EXISTS scoring_strategy VARCHAR(20) NOT NULL DEFAULT 'win_ratio'
+			CHECK (scoring_strategy IN ('win_ratio', 'rating', 'points', 'ladder'));`,
+
+		`CREATE TABLE IF NOT EXISTS teams (
+			team_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			game_id UUID REFERENCES games(game_id) ON DELETE CASCADE,
+			name VARCHAR(50) NOT NULL,
+			created_by UUID REFERENCES users(user_id) ON DELETE SET NULL,
+			wins INT NOT NULL DEFAULT 0,
+			losses INT NOT NULL DEFAULT 0,
+			draws INT NOT NULL DEFAULT 0,
+			rating FLOAT NOT NULL DEFAULT 1500,
+			rating_deviation FLOAT NOT NULL DEFAULT 350,
+			volatility FLOAT NOT NULL DEFAULT 0.06,
+			created_at TIMESTAMPTZ DEFAULT NOW()
+		);`,
+
+		`CREATE UNIQUE INDEX IF NOT EXISTS teams_game_name_key ON teams (game_id, lower(name));`,
+
+		`CREATE TABLE IF NOT EXISTS team_members (
+			team_id UUID REFERENCES teams(team_id) ON DELETE CASCADE,
+			user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+			PRIMARY KEY (team_id, user_id)
+		);`,
 	}
 
 	for _, table := range createTables {
@@ -0,0 +1,81 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/jobs"
+	"project2/internal/domain/entities"
+	mock_interfaces "project2/tests/mocks/repository"
+	"testing"
+	"time"
+)
+
+func TestMinPlayersJob_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mock_interfaces.NewMockSlotRepository(ctrl)
+	mockGameRepo := mock_interfaces.NewMockGameRepository(ctrl)
+	mockBookingRepo := mock_interfaces.NewMockBookingRepository(ctrl)
+	mockNotificationRepo := mock_interfaces.NewMockNotificationRepository(ctrl)
+	job := jobs.NewMinPlayersJob(mockSlotRepo, mockGameRepo, mockBookingRepo, mockNotificationRepo)
+
+	ctx := context.Background()
+	gameID := uuid.New()
+	soloGameID := uuid.New()
+	slot := entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(10 * time.Minute)}
+	otherSlot := entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(10 * time.Minute)}
+	soloSlot := entities.Slot{SlotID: uuid.New(), GameID: soloGameID, StartTime: time.Now().Add(10 * time.Minute)}
+
+	t.Run("cancels under-filled slots and notifies the players", func(t *testing.T) {
+		firstUser, secondUser := uuid.New(), uuid.New()
+
+		mockSlotRepo.EXPECT().FetchSlotsStartingBetween(ctx, gomock.Any(), gomock.Any()).
+			Return([]entities.Slot{slot, otherSlot, soloSlot}, nil)
+		mockGameRepo.EXPECT().FetchGameByID(ctx, gameID).
+			Return(&entities.Game{GameID: gameID, GameName: "Foosball", MinPlayers: 4}, nil)
+		mockGameRepo.EXPECT().FetchGameByID(ctx, soloGameID).
+			Return(&entities.Game{GameID: soloGameID, GameName: "Darts", MinPlayers: 1}, nil)
+		mockBookingRepo.EXPECT().CancelBookingsBelowMinPlayers(ctx, slot.SlotID, 4).
+			Return([]uuid.UUID{firstUser, secondUser}, nil)
+		mockBookingRepo.EXPECT().CancelBookingsBelowMinPlayers(ctx, otherSlot.SlotID, 4).
+			Return(nil, nil)
+		mockNotificationRepo.EXPECT().CreateNotification(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *entities.Notification) (uuid.UUID, error) {
+				assert.Equal(t, firstUser, notification.UserID)
+				assert.Contains(t, notification.Message, "Foosball")
+				return uuid.New(), nil
+			})
+		mockNotificationRepo.EXPECT().CreateNotification(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *entities.Notification) (uuid.UUID, error) {
+				assert.Equal(t, secondUser, notification.UserID)
+				return uuid.New(), nil
+			})
+
+		err := job.Run(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("fails when the slots cannot be fetched", func(t *testing.T) {
+		mockSlotRepo.EXPECT().FetchSlotsStartingBetween(ctx, gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("db error"))
+
+		err := job.Run(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("fails when the bookings cannot be cancelled", func(t *testing.T) {
+		mockSlotRepo.EXPECT().FetchSlotsStartingBetween(ctx, gomock.Any(), gomock.Any()).
+			Return([]entities.Slot{slot}, nil)
+		mockGameRepo.EXPECT().FetchGameByID(ctx, gameID).
+			Return(&entities.Game{GameID: gameID, MinPlayers: 2}, nil)
+		mockBookingRepo.EXPECT().CancelBookingsBelowMinPlayers(ctx, slot.SlotID, 2).
+			Return(nil, errors.New("db error"))
+
+		err := job.Run(ctx)
+		assert.Error(t, err)
+	})
+}
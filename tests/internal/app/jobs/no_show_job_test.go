@@ -0,0 +1,57 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/jobs"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	mock_interfaces "project2/tests/mocks/repository"
+	"testing"
+	"time"
+)
+
+func TestNoShowJob_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mock_interfaces.NewMockBookingRepository(ctrl)
+	mockNotificationRepo := mock_interfaces.NewMockNotificationRepository(ctrl)
+	job := jobs.NewNoShowJob(mockBookingRepo, mockNotificationRepo)
+
+	ctx := context.Background()
+
+	t.Run("marks the bookings whose check-in window closed and notifies the players", func(t *testing.T) {
+		userID := uuid.New()
+
+		mockBookingRepo.EXPECT().MarkNoShows(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, startedAfter, startedBefore time.Time) ([]models.NoShow, error) {
+				window := time.Duration(config.CheckInWindowMinutes) * time.Minute
+				windowClosed := time.Now().Add(-window)
+				assert.WithinDuration(t, windowClosed, startedBefore, time.Second)
+				// Slots whose window closed long ago, e.g. while the application was down, are left alone
+				assert.Equal(t, window+config.JobInterval, startedBefore.Sub(startedAfter))
+				return []models.NoShow{{UserId: userID, GameName: "Chess", StartTime: windowClosed.Add(-time.Minute)}}, nil
+			})
+		mockNotificationRepo.EXPECT().CreateNotification(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *entities.Notification) (uuid.UUID, error) {
+				assert.Equal(t, userID, notification.UserID)
+				assert.Contains(t, notification.Message, "no-show")
+				return uuid.New(), nil
+			})
+
+		err := job.Run(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns the repository error", func(t *testing.T) {
+		mockBookingRepo.EXPECT().MarkNoShows(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		err := job.Run(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,35 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/jobs"
+	mock_services "project2/tests/mocks/service"
+	"testing"
+)
+
+func TestResultConfirmationJob_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockResultService := mock_services.NewMockResultService(ctrl)
+	job := jobs.NewResultConfirmationJob(mockResultService)
+
+	ctx := context.Background()
+
+	t.Run("confirms the results whose deadline has passed", func(t *testing.T) {
+		mockResultService.EXPECT().ConfirmExpiredResults(ctx).Return(2, nil)
+
+		err := job.Run(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns the service error", func(t *testing.T) {
+		mockResultService.EXPECT().ConfirmExpiredResults(ctx).Return(0, errors.New("db error"))
+
+		err := job.Run(ctx)
+		assert.Error(t, err)
+	})
+}
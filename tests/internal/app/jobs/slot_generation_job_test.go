@@ -0,0 +1,85 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/jobs"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	mock_interfaces "project2/tests/mocks/repository"
+	mock_services "project2/tests/mocks/service"
+	"testing"
+	"time"
+)
+
+func TestSlotGenerationJob_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mock_interfaces.NewMockSlotRepository(ctrl)
+	mockGameRepo := mock_interfaces.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mock_interfaces.NewMockBlackoutRepository(ctrl)
+	mockHolidayRepo := mock_interfaces.NewMockHolidayRepository(ctrl)
+	mockRecurringService := mock_services.NewMockRecurringBookingService(ctrl)
+	job := jobs.NewSlotGenerationJob(mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo, mockRecurringService)
+
+	ctx := context.Background()
+	original := config.WorkingDays
+	defer func() { config.WorkingDays = original }()
+	config.WorkingDays = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+	t.Run("books the recurring bookings into the slots it created", func(t *testing.T) {
+		game := entities.Game{GameID: uuid.New(), GameName: "Chess", OpenTime: "09:00", CloseTime: "10:00", SlotDuration: 60}
+		slotID := uuid.New()
+
+		mockGameRepo.EXPECT().FetchAllGames(ctx).Return([]entities.Game{game}, nil)
+		mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockHolidayRepo.EXPECT().FetchHolidaysBetween(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(ctx, game.GameID, gomock.Any()).
+			Return([]entities.Slot{}, nil).
+			Times(config.BookingHorizonDays)
+
+		// Only today's slot is new, the others were created concurrently by another run
+		mockSlotRepo.EXPECT().CreateSlot(ctx, gomock.Any()).Return(slotID, nil)
+		mockSlotRepo.EXPECT().CreateSlot(ctx, gomock.Any()).Return(uuid.Nil, nil).Times(config.BookingHorizonDays - 1)
+		mockRecurringService.EXPECT().MaterialiseRecurringBookings(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, slots []entities.Slot) error {
+				assert.Len(t, slots, 1)
+				assert.Equal(t, slotID, slots[0].SlotID)
+				return nil
+			})
+
+		err := job.Run(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns an error when the games cannot be fetched", func(t *testing.T) {
+		mockGameRepo.EXPECT().FetchAllGames(ctx).Return(nil, errors.New("db error"))
+
+		err := job.Run(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestSlotGenerationJob_Trigger(t *testing.T) {
+	job := jobs.NewSlotGenerationJob(nil, nil, nil, nil, nil)
+
+	// Triggers made before the pending one is handled are merged into it
+	job.Trigger()
+	job.Trigger()
+
+	assert.Len(t, job.Triggers(), 1)
+}
+
+func TestNextDailyRun(t *testing.T) {
+	location := time.FixedZone("IST", 5*60*60+30*60)
+
+	now := time.Date(2030, 1, 10, 0, 1, 0, 0, location)
+	assert.Equal(t, time.Date(2030, 1, 10, 0, 5, 0, 0, location), jobs.NextDailyRun(now, "00:05", location))
+
+	now = time.Date(2030, 1, 10, 0, 5, 0, 0, location)
+	assert.Equal(t, time.Date(2030, 1, 11, 0, 5, 0, 0, location), jobs.NextDailyRun(now, "00:05", location))
+}
@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/repositories"
+	"project2/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestCreateBlackout(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBlackoutRepo(db)
+
+	gameID := uuid.New()
+	start := time.Date(2030, 1, 10, 9, 0, 0, 0, time.UTC)
+	blackout := &entities.Blackout{GameID: &gameID, StartTime: start, EndTime: start.Add(2 * time.Hour), Reason: "Cleaning"}
+	blackoutID := uuid.New()
+	userID := uuid.New()
+	nullableGameID := uuid.NullUUID{UUID: gameID, Valid: true}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO blackouts").
+		WithArgs(nullableGameID, blackout.StartTime, blackout.EndTime, "Cleaning").
+		WillReturnRows(sqlmock.NewRows([]string{"blackout_id"}).AddRow(blackoutID))
+	mock.ExpectQuery(`DELETE FROM bookings b USING slots s, games g (.+) AND s.start_time > NOW\(\)`).
+		WithArgs(blackout.StartTime, blackout.EndTime, nullableGameID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "game_name", "start_time"}).AddRow(userID, "Chess", start))
+	mock.ExpectExec(`DELETE FROM slots WHERE (.+) AND start_time > NOW\(\)`).
+		WithArgs(blackout.StartTime, blackout.EndTime, nullableGameID).
+		WillReturnResult(sqlmock.NewResult(0, 6))
+	mock.ExpectCommit()
+
+	id, cancelled, err := repo.CreateBlackout(context.TODO(), blackout)
+	assert.NoError(t, err)
+	assert.Equal(t, blackoutID, id)
+	assert.Len(t, cancelled, 1)
+	assert.Equal(t, userID, cancelled[0].UserId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchBlackoutsBetween(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBlackoutRepo(db)
+
+	from := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	gameID := uuid.New()
+	rows := sqlmock.NewRows([]string{"blackout_id", "game_id", "start_time", "end_time", "reason", "created_at"}).
+		AddRow(uuid.New(), nil, from, from.Add(time.Hour), "All-hands", from).
+		AddRow(uuid.New(), gameID, from, from.Add(time.Hour), "Cleaning", from)
+
+	mock.ExpectQuery("SELECT (.+) FROM blackouts WHERE start_time < (.+) AND end_time > (.+)").
+		WithArgs(from, to).
+		WillReturnRows(rows)
+
+	blackouts, err := repo.FetchBlackoutsBetween(context.TODO(), from, to)
+	assert.NoError(t, err)
+	assert.Len(t, blackouts, 2)
+	assert.Nil(t, blackouts[0].GameID)
+	assert.Equal(t, gameID, *blackouts[1].GameID)
+}
+
+func TestDeleteBlackout_NotFound(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBlackoutRepo(db)
+
+	id := uuid.New()
+	mock.ExpectExec("DELETE FROM blackouts WHERE blackout_id = ?").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.DeleteBlackout(context.TODO(), id)
+	assert.EqualError(t, err, "no blackout found with ID "+id.String())
+}
@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"project2/internal/app/repositories"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestCreateBookingWithinCapacity_ParallelBookings fires many bookings at one slot at the same time against a real
+// Postgres and checks that the slot never takes more than its maximum number of players.
+// It needs TEST_DATABASE_URL to point at a database whose tables were created by scripts.InitializeTables.
+func TestCreateBookingWithinCapacity_ParallelBookings(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Ping())
+
+	ctx := context.Background()
+	const maxPlayers, attempts = 4, 25
+
+	var gameID uuid.UUID
+	err = db.QueryRowContext(ctx, `INSERT INTO games (game_name, min_players, max_players, instances) VALUES ($1, 2, $2, 1) RETURNING game_id`,
+		"concurrency test "+uuid.NewString(), maxPlayers).Scan(&gameID)
+	require.NoError(t, err)
+
+	userIDs := make([]uuid.UUID, attempts)
+	for i := range userIDs {
+		email := fmt.Sprintf("%s@concurrency.test", uuid.NewString())
+		err := db.QueryRowContext(ctx, `INSERT INTO users (username, email, password) VALUES ($1, $2, 'secret') RETURNING user_id`,
+			fmt.Sprintf("player%d", i), email).Scan(&userIDs[i])
+		require.NoError(t, err)
+	}
+	defer func() {
+		db.ExecContext(ctx, `DELETE FROM games WHERE game_id = $1`, gameID)
+		db.ExecContext(ctx, `DELETE FROM users WHERE user_id = ANY($1)`, pq.Array(userIDs))
+	}()
+
+	start := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Minute)
+	var slotID uuid.UUID
+	err = db.QueryRowContext(ctx, `INSERT INTO slots (game_id, slot_date, start_time, end_time) VALUES ($1, $2, $3, $4) RETURNING slot_id`,
+		gameID, start, start, start.Add(20*time.Minute)).Scan(&slotID)
+	require.NoError(t, err)
+
+	repo := repositories.NewBookingRepo(db)
+	var wg sync.WaitGroup
+	var booked, full atomic.Int32
+	ready := make(chan struct{})
+	errs := make(chan error, attempts)
+	for _, userID := range userIDs {
+		wg.Add(1)
+		go func(userID uuid.UUID) {
+			defer wg.Done()
+			<-ready
+			_, err := repo.CreateBookingWithinCapacity(ctx, &entities.Booking{SlotID: slotID, UserID: userID}, maxPlayers)
+			switch {
+			case err == nil:
+				booked.Add(1)
+			case errors.Is(err, domain_errors.ErrSlotFull):
+				full.Add(1)
+			default:
+				errs <- err
+			}
+		}(userID)
+	}
+	close(ready)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected booking error: %v", err)
+	}
+
+	var count int
+	var isBooked bool
+	err = db.QueryRowContext(ctx, `SELECT COUNT(b.booking_id), s.is_booked FROM slots s LEFT JOIN bookings b ON b.slot_id = s.slot_id
+	                               WHERE s.slot_id = $1 GROUP BY s.slot_id`, slotID).Scan(&count, &isBooked)
+	require.NoError(t, err)
+	assert.Equal(t, maxPlayers, count)
+	assert.Equal(t, int32(maxPlayers), booked.Load())
+	assert.Equal(t, int32(attempts-maxPlayers), full.Load())
+	assert.True(t, isBooked)
+}
@@ -2,11 +2,16 @@ package repository_test
 
 import (
 	"context"
+	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"project2/internal/app/repositories"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"project2/internal/models"
 	"testing"
 	"time"
 )
@@ -32,6 +37,485 @@ func TestCreateBooking(t *testing.T) {
 	assert.NotEqual(t, uuid.Nil, id)
 }
 
+func TestCreateBookingWithinCapacity(t *testing.T) {
+	slotID := uuid.New()
+	userID := uuid.New()
+	booking := &entities.Booking{SlotID: slotID, UserID: userID}
+
+	t.Run("books the last seat and marks the slot as booked", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		bookingID := uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(1, 0))
+		mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM bookings b (.+) JOIN slots target ON target.slot_id = ?").
+			WithArgs(userID, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectQuery("INSERT INTO bookings").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(bookingID))
+		mock.ExpectExec(`UPDATE slots SET is_booked = TRUE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		id, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, bookingID, id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects the booking when the slot is full", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(2, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 2)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a booking overlapping another booking of the user", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		conflictingID := uuid.New()
+		start := time.Date(2030, 1, 7, 14, 0, 0, 0, config.Location())
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(0, 0))
+		mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM bookings b (.+) JOIN slots target ON target.slot_id = ?").
+			WithArgs(userID, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}).
+				AddRow(conflictingID, "Pool", start, start.Add(20*time.Minute)))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 4)
+		var overlap *domain_errors.BookingOverlapError
+		assert.ErrorAs(t, err, &overlap)
+		assert.Equal(t, conflictingID, overlap.BookingID)
+		assert.Equal(t, "booking overlaps your Pool booking on Mon, 07 Jan from 02:00 PM to 02:20 PM", err.Error())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a second booking by the same user", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(1, 1))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 4)
+		assert.ErrorIs(t, err, domain_errors.ErrAlreadyBooked)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// TestCreateBookingWithinCapacity_LockOrder checks that the capacity check cannot race: the slot row is
+// locked before the bookings are counted, so a concurrent booking for the same slot waits on the lock and
+// then counts the seat taken by the first one.
+func TestCreateBookingWithinCapacity_LockOrder(t *testing.T) {
+	slotID := uuid.New()
+	userID := uuid.New()
+	booking := &entities.Booking{SlotID: slotID, UserID: userID}
+
+	t.Run("locks the slot before counting and inserting", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		// Any statement running before the lock would not match the first expectation and fail the booking
+		mock.MatchExpectationsInOrder(true)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(3, 0))
+		mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM bookings b (.+) JOIN slots target ON target.slot_id = ?").
+			WithArgs(userID, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectQuery("INSERT INTO bookings").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(uuid.New()))
+		mock.ExpectExec(`UPDATE slots SET is_booked = TRUE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 4)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("neither counts nor inserts when the slot cannot be locked", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnError(errors.New("canceling statement due to lock timeout"))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 4)
+		assert.EqualError(t, err, "failed to lock slot: canceling statement due to lock timeout")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects the booking without an insert once the slot is full", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		// Parallel bookings are covered against a real database by TestCreateBookingWithinCapacity_ParallelBookings
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(true))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(4, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateBookingWithinCapacity(context.TODO(), booking, 4)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateGroupBooking(t *testing.T) {
+	slotID := uuid.New()
+	firstUser, secondUser := uuid.New(), uuid.New()
+
+	expectPartyLocks := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`SELECT slot_id FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = ANY\(\$1\) ORDER BY user_id FOR UPDATE`).
+			WithArgs(pq.Array([]uuid.UUID{firstUser, secondUser})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+	}
+	expectSeat := func(mock sqlmock.Sqlmock, userID uuid.UUID, booked int) {
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(booked, 0))
+	}
+
+	t.Run("books every member of the party", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		expectPartyLocks(mock)
+		for i, userID := range []uuid.UUID{firstUser, secondUser} {
+			expectSeat(mock, userID, i)
+			mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+				WithArgs(userID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery("SELECT (.+) FROM bookings b (.+) JOIN slots target ON target.slot_id = ?").
+				WithArgs(userID, slotID).
+				WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+			mock.ExpectQuery("INSERT INTO bookings").
+				WithArgs(slotID, userID).
+				WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(uuid.New()))
+		}
+		mock.ExpectExec(`UPDATE slots SET is_booked = TRUE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		ids, err := repo.CreateGroupBooking(context.TODO(), slotID, []uuid.UUID{firstUser, secondUser}, 2)
+		assert.NoError(t, err)
+		assert.Len(t, ids, 2)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("books nobody when a member does not fit", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		expectPartyLocks(mock)
+		expectSeat(mock, firstUser, 1)
+		mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+			WithArgs(firstUser).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM bookings b (.+) JOIN slots target ON target.slot_id = ?").
+			WithArgs(firstUser, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectQuery("INSERT INTO bookings").
+			WithArgs(slotID, firstUser).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(uuid.New()))
+		mock.ExpectExec(`UPDATE slots SET is_booked = TRUE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(true))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, secondUser).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(2, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateGroupBooking(context.TODO(), slotID, []uuid.UUID{firstUser, secondUser}, 2)
+		var memberErr *domain_errors.GroupMemberError
+		assert.ErrorAs(t, err, &memberErr)
+		assert.Equal(t, secondUser, memberErr.UserID)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCancelBookingsBelowMinPlayers(t *testing.T) {
+	slotID := uuid.New()
+
+	t.Run("cancels every booking and reopens the slot", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		userID := uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT slot_id FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id"}).AddRow(slotID))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM bookings WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`DELETE FROM bookings WHERE slot_id = \$1 RETURNING user_id`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(userID))
+		mock.ExpectExec(`UPDATE slots SET is_booked = FALSE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		userIDs, err := repo.CancelBookingsBelowMinPlayers(context.TODO(), slotID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{userID}, userIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps the bookings when enough players have joined", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT slot_id FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id"}).AddRow(slotID))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM bookings WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectRollback()
+
+		userIDs, err := repo.CancelBookingsBelowMinPlayers(context.TODO(), slotID, 2)
+		assert.NoError(t, err)
+		assert.Empty(t, userIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCancelBookingAndPromote(t *testing.T) {
+	slotID := uuid.New()
+	bookingID := uuid.New()
+
+	expectCancellation := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT slot_id FROM bookings WHERE booking_id = \$1`).
+			WithArgs(bookingID).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id"}).AddRow(slotID))
+		mock.ExpectExec(`SELECT slot_id FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM bookings WHERE booking_id = \$1`).
+			WithArgs(bookingID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE slots SET is_booked = FALSE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("promotes the first user on the waitlist", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		waiterID := uuid.New()
+		expectCancellation(mock)
+		mock.ExpectQuery("DELETE FROM waitlist").
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(waiterID))
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(slotID, waiterID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "count"}).AddRow(1, 0))
+		mock.ExpectExec(`SELECT user_id FROM users WHERE user_id = \$1 FOR UPDATE`).
+			WithArgs(waiterID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM bookings b (.+) JOIN slots target ON target.slot_id = ?").
+			WithArgs(waiterID, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectQuery("INSERT INTO bookings").
+			WithArgs(slotID, waiterID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(uuid.New()))
+		mock.ExpectExec(`UPDATE slots SET is_booked = TRUE WHERE slot_id = \$1`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		promoted, err := repo.CancelBookingAndPromote(context.TODO(), bookingID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, waiterID, promoted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reopens the slot when nobody is waiting", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		expectCancellation(mock)
+		mock.ExpectQuery("DELETE FROM waitlist").
+			WithArgs(slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+		mock.ExpectCommit()
+
+		promoted, err := repo.CancelBookingAndPromote(context.TODO(), bookingID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, promoted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAddToWaitlist(t *testing.T) {
+	entry := &entities.WaitlistEntry{SlotID: uuid.New(), UserID: uuid.New()}
+
+	t.Run("queues the user for a full slot", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(entry.SlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(true))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM bookings WHERE slot_id = \$1 AND user_id = \$2`).
+			WithArgs(entry.SlotID, entry.UserID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO waitlist").
+			WithArgs(entry.SlotID, entry.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.AddToWaitlist(context.TODO(), entry)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a slot with free seats", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(entry.SlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectRollback()
+
+		err := repo.AddToWaitlist(context.TODO(), entry)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotNotFull)
+	})
+
+	t.Run("rejects a user who is already waiting", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT is_booked FROM slots WHERE slot_id = \$1 FOR UPDATE`).
+			WithArgs(entry.SlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(true))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM bookings WHERE slot_id = \$1 AND user_id = \$2`).
+			WithArgs(entry.SlotID, entry.UserID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO waitlist").
+			WithArgs(entry.SlotID, entry.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.AddToWaitlist(context.TODO(), entry)
+		assert.ErrorIs(t, err, domain_errors.ErrAlreadyWaitlisted)
+	})
+}
+
+func TestFetchWaitlistPosition(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBookingRepo(db)
+
+	slotID := uuid.New()
+	userID := uuid.New()
+
+	mock.ExpectQuery("SELECT COALESCE(.+) FROM (.+) waitlist WHERE slot_id = ?").
+		WithArgs(slotID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"position", "count"}).AddRow(2, 3))
+
+	position, size, err := repo.FetchWaitlistPosition(context.TODO(), slotID, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, position)
+	assert.Equal(t, 3, size)
+}
+
 func TestFetchBookingByID(t *testing.T) {
 	db, mock := setup()
 	defer db.Close()
@@ -116,8 +600,9 @@ func TestFetchUpcomingBookingsByUserID(t *testing.T) {
 	slotID := uuid.New()
 
 	// Mock the query to fetch upcoming bookings
-	rows := sqlmock.NewRows([]string{"game_name", "slot_id", "date", "start_time", "end_time"}).
-		AddRow("Table Tennis", slotID, time.Now(), time.Now().Add(1*time.Hour), time.Now().Add(2*time.Hour))
+	bookingID := uuid.New()
+	rows := sqlmock.NewRows([]string{"booking_id", "game_name", "slot_id", "instance", "date", "start_time", "end_time", "check_in_code"}).
+		AddRow(bookingID, "Table Tennis", slotID, 2, time.Now(), time.Now().Add(1*time.Hour), time.Now().Add(2*time.Hour), "042137")
 
 	mock.ExpectQuery("SELECT (.+) FROM bookings").
 		WithArgs(userID).
@@ -138,6 +623,10 @@ func TestFetchUpcomingBookingsByUserID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, bookings, 1)
 	assert.Equal(t, "Table Tennis", bookings[0].GameName)
+	assert.Equal(t, bookingID, bookings[0].BookingId)
+	assert.Equal(t, slotID, bookings[0].SlotId)
+	assert.Equal(t, 2, bookings[0].Instance)
+	assert.Equal(t, "042137", bookings[0].CheckInCode)
 	assert.Equal(t, "john_doe", bookings[0].BookedUsers[0]) // Assuming BookedUsers is a field in your result struct
 }
 
@@ -255,3 +744,254 @@ func TestFetchBookingBySlotAndUserId(t *testing.T) {
 	assert.Equal(t, endTime, booking.EndTime)
 	//assert.Equal(t, []string{"john_doe", "jane_smith"}, booking.BookedUsers)
 }
+
+func TestFetchBookingCountsBySlotIDs(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBookingRepo(db)
+
+	firstSlotID := uuid.New()
+	secondSlotID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"slot_id", "count"}).
+		AddRow(firstSlotID, 3).
+		AddRow(secondSlotID, 1)
+
+	mock.ExpectQuery("SELECT slot_id, COUNT(.+) FROM bookings WHERE slot_id = ANY").
+		WithArgs(pq.Array([]uuid.UUID{firstSlotID, secondSlotID, uuid.Nil})).
+		WillReturnRows(rows)
+
+	counts, err := repo.FetchBookingCountsBySlotIDs(context.TODO(), []uuid.UUID{firstSlotID, secondSlotID, uuid.Nil})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{firstSlotID: 3, secondSlotID: 1}, counts)
+}
+
+func TestCheckInBooking(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBookingRepo(db)
+
+	userID := uuid.New()
+	bookingID := uuid.New()
+	from := time.Date(2030, 1, 10, 12, 50, 0, 0, time.UTC)
+	to := from.Add(20 * time.Minute)
+
+	mock.ExpectQuery("UPDATE bookings b SET attendance = 'checked_in'").
+		WithArgs(userID, "042137", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(bookingID))
+
+	id, err := repo.CheckInBooking(context.TODO(), userID, "042137", from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, bookingID, id)
+
+	// Wrong codes and bookings outside the window update nothing
+	mock.ExpectQuery("UPDATE bookings b SET attendance = 'checked_in'").
+		WithArgs(userID, "000000", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"booking_id"}))
+
+	_, err = repo.CheckInBooking(context.TODO(), userID, "000000", from, to)
+	assert.ErrorIs(t, err, domain_errors.ErrInvalidCheckInCode)
+}
+
+func TestMarkNoShows(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBookingRepo(db)
+
+	userID := uuid.New()
+	startedBefore := time.Date(2030, 1, 10, 12, 50, 0, 0, time.UTC)
+	startedAfter := startedBefore.Add(-11 * time.Minute)
+	startTime := startedBefore.Add(-5 * time.Minute)
+
+	mock.ExpectQuery(`UPDATE bookings b SET attendance = 'no_show' (.+) AND s.start_time >= \$1 AND s.start_time < \$2`).
+		WithArgs(startedAfter, startedBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "game_name", "start_time"}).AddRow(userID, "Chess", startTime))
+
+	noShows, err := repo.MarkNoShows(context.TODO(), startedAfter, startedBefore)
+	assert.NoError(t, err)
+	assert.Len(t, noShows, 1)
+	assert.Equal(t, userID, noShows[0].UserId)
+	assert.Equal(t, "Chess", noShows[0].GameName)
+}
+
+func TestFetchAttendanceStats(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewBookingRepo(db)
+
+	userID := uuid.New()
+	mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE user_id = ?").
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"check_ins", "no_shows"}).AddRow(7, 2))
+
+	stats, err := repo.FetchAttendanceStats(context.TODO(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.AttendanceStats{CheckIns: 7, NoShows: 2}, stats)
+}
+
+func TestTransferBooking(t *testing.T) {
+	bookingID := uuid.New()
+	slotID := uuid.New()
+	toUserID := uuid.New()
+
+	t.Run("moves the booking to the recipient", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT slot_id FROM bookings WHERE booking_id = ?").
+			WithArgs(bookingID).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id"}).AddRow(slotID))
+		mock.ExpectExec("SELECT slot_id FROM slots WHERE slot_id = (.+) FOR UPDATE").
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = (.+) AND user_id = ?").
+			WithArgs(slotID, toUserID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("SELECT user_id FROM users WHERE user_id = (.+) FOR UPDATE").
+			WithArgs(toUserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT b.booking_id, g.game_name, s.start_time, s.end_time FROM bookings b").
+			WithArgs(toUserID, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectExec("UPDATE bookings SET user_id = ?").
+			WithArgs(bookingID, toUserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM waitlist WHERE slot_id = (.+) AND user_id = ?").
+			WithArgs(slotID, toUserID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.TransferBooking(context.TODO(), bookingID, toUserID)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a recipient with an overlapping booking", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT slot_id FROM bookings WHERE booking_id = ?").
+			WithArgs(bookingID).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id"}).AddRow(slotID))
+		mock.ExpectExec("SELECT slot_id FROM slots WHERE slot_id = (.+) FOR UPDATE").
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = (.+) AND user_id = ?").
+			WithArgs(slotID, toUserID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("SELECT user_id FROM users WHERE user_id = (.+) FOR UPDATE").
+			WithArgs(toUserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT b.booking_id, g.game_name, s.start_time, s.end_time FROM bookings b").
+			WithArgs(toUserID, slotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}).
+				AddRow(uuid.New(), "Carrom", time.Now(), time.Now().Add(time.Hour)))
+		mock.ExpectRollback()
+
+		err := repo.TransferBooking(context.TODO(), bookingID, toUserID)
+		var overlap *domain_errors.BookingOverlapError
+		assert.ErrorAs(t, err, &overlap)
+		assert.Equal(t, "Carrom", overlap.GameName)
+	})
+}
+
+func TestRescheduleBooking(t *testing.T) {
+	bookingID := uuid.New()
+	newBookingID := uuid.New()
+	userID := uuid.New()
+	oldSlotID := uuid.New()
+	newSlotID := uuid.New()
+
+	expectMove := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT slot_id, user_id FROM bookings WHERE booking_id = ?").
+			WithArgs(bookingID).
+			WillReturnRows(sqlmock.NewRows([]string{"slot_id", "user_id"}).AddRow(oldSlotID, userID))
+		mock.ExpectExec("SELECT slot_id FROM slots WHERE slot_id = ANY(.+) ORDER BY slot_id FOR UPDATE").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("DELETE FROM bookings WHERE booking_id = ?").
+			WithArgs(bookingID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT is_booked FROM slots WHERE slot_id = (.+) FOR UPDATE").
+			WithArgs(newSlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+	}
+
+	t.Run("moves the booking and promotes the first waiter into the old slot", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+		waiterID := uuid.New()
+
+		expectMove(mock)
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(newSlotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "user_count"}).AddRow(1, 0))
+		mock.ExpectExec("SELECT user_id FROM users WHERE user_id = (.+) FOR UPDATE").
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT b.booking_id, g.game_name, s.start_time, s.end_time FROM bookings b").
+			WithArgs(userID, newSlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectQuery("INSERT INTO bookings").
+			WithArgs(newSlotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(newBookingID))
+		mock.ExpectExec("UPDATE slots SET is_booked = TRUE WHERE slot_id = ?").
+			WithArgs(newSlotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM waitlist WHERE slot_id = (.+) AND user_id = ?").
+			WithArgs(newSlotID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("UPDATE slots SET is_booked = FALSE WHERE slot_id = ?").
+			WithArgs(oldSlotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("DELETE FROM waitlist").
+			WithArgs(oldSlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(waiterID))
+		mock.ExpectQuery("SELECT is_booked FROM slots WHERE slot_id = (.+) FOR UPDATE").
+			WithArgs(oldSlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_booked"}).AddRow(false))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(oldSlotID, waiterID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "user_count"}).AddRow(1, 0))
+		mock.ExpectExec("SELECT user_id FROM users WHERE user_id = (.+) FOR UPDATE").
+			WithArgs(waiterID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT b.booking_id, g.game_name, s.start_time, s.end_time FROM bookings b").
+			WithArgs(waiterID, oldSlotID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id", "game_name", "start_time", "end_time"}))
+		mock.ExpectQuery("INSERT INTO bookings").
+			WithArgs(oldSlotID, waiterID).
+			WillReturnRows(sqlmock.NewRows([]string{"booking_id"}).AddRow(uuid.New()))
+		mock.ExpectExec("UPDATE slots SET is_booked = TRUE WHERE slot_id = ?").
+			WithArgs(oldSlotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		id, promotedUserID, err := repo.RescheduleBooking(context.TODO(), bookingID, newSlotID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, newBookingID, id)
+		assert.Equal(t, waiterID, promotedUserID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps the old booking when the new slot is full", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewBookingRepo(db)
+
+		expectMove(mock)
+		mock.ExpectQuery("SELECT COUNT(.+) FROM bookings WHERE slot_id = ?").
+			WithArgs(newSlotID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "user_count"}).AddRow(2, 0))
+		mock.ExpectRollback()
+
+		_, _, err := repo.RescheduleBooking(context.TODO(), bookingID, newSlotID, 2)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -20,21 +20,28 @@ func TestGameRepo_FetchGameByID(t *testing.T) {
 	// Test Data
 	gameID := uuid.New()
 	expectedGame := &entities.Game{
-		GameID:     gameID,
-		GameName:   "Test Game",
-		MinPlayers: 2,
-		MaxPlayers: 4,
-		Instances:  1,
-		IsActive:   true,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		GameID:       gameID,
+		GameName:     "Test Game",
+		MinPlayers:   2,
+		MaxPlayers:   4,
+		Instances:    1,
+		OpenTime:     "09:00",
+		CloseTime:    "18:00",
+		SlotDuration: 20,
+		WeekdaySchedules: map[time.Weekday]entities.GameSchedule{
+			time.Saturday: {OpenTime: "10:00", CloseTime: "14:00", SlotDuration: 30},
+		},
+		IsActive:        true,
+		ScoringStrategy: "rating",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	// Success case
-	mock.ExpectQuery(`SELECT game_id, game_name, min_players, max_players, instances, is_active, created_at, updated_at FROM games WHERE game_id = \$1`).
+	mock.ExpectQuery(`SELECT game_id, game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy, created_at, updated_at FROM games WHERE game_id = \$1`).
 		WithArgs(gameID).
-		WillReturnRows(sqlmock.NewRows([]string{"game_id", "game_name", "min_players", "max_players", "instances", "is_active", "created_at", "updated_at"}).
-			AddRow(expectedGame.GameID, expectedGame.GameName, expectedGame.MinPlayers, expectedGame.MaxPlayers, expectedGame.Instances, expectedGame.IsActive, expectedGame.CreatedAt, expectedGame.UpdatedAt))
+		WillReturnRows(sqlmock.NewRows([]string{"game_id", "game_name", "min_players", "max_players", "instances", "open_time", "close_time", "slot_duration", "weekday_schedules", "is_active", "scoring_strategy", "created_at", "updated_at"}).
+			AddRow(expectedGame.GameID, expectedGame.GameName, expectedGame.MinPlayers, expectedGame.MaxPlayers, expectedGame.Instances, expectedGame.OpenTime, expectedGame.CloseTime, expectedGame.SlotDuration, []byte(`{"6":{"open_time":"10:00","close_time":"14:00","slot_duration":30}}`), expectedGame.IsActive, expectedGame.ScoringStrategy, expectedGame.CreatedAt, expectedGame.UpdatedAt))
 
 	repo := repositories.NewGameRepo(db)
 	ctx := context.Background()
@@ -45,7 +52,7 @@ func TestGameRepo_FetchGameByID(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 
 	// No rows case
-	mock.ExpectQuery(`SELECT game_id, game_name, min_players, max_players, instances, is_active, created_at, updated_at FROM games WHERE game_id = \$1`).
+	mock.ExpectQuery(`SELECT game_id, game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy, created_at, updated_at FROM games WHERE game_id = \$1`).
 		WithArgs(gameID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -61,31 +68,39 @@ func TestGameRepo_FetchAllGames(t *testing.T) {
 
 	expectedGames := []entities.Game{
 		{
-			GameID:     uuid.New(),
-			GameName:   "Game 1",
-			MinPlayers: 2,
-			MaxPlayers: 4,
-			Instances:  1,
-			IsActive:   true,
-			CreatedAt:  time.Now(),
-			UpdatedAt:  time.Now(),
+			GameID:          uuid.New(),
+			GameName:        "Game 1",
+			MinPlayers:      2,
+			MaxPlayers:      4,
+			Instances:       1,
+			OpenTime:        "09:00",
+			CloseTime:       "18:00",
+			SlotDuration:    20,
+			IsActive:        true,
+			ScoringStrategy: "win_ratio",
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
 		},
 		{
-			GameID:     uuid.New(),
-			GameName:   "Game 2",
-			MinPlayers: 2,
-			MaxPlayers: 6,
-			Instances:  2,
-			IsActive:   false,
-			CreatedAt:  time.Now(),
-			UpdatedAt:  time.Now(),
+			GameID:          uuid.New(),
+			GameName:        "Game 2",
+			MinPlayers:      2,
+			MaxPlayers:      6,
+			Instances:       2,
+			OpenTime:        "10:00",
+			CloseTime:       "20:00",
+			SlotDuration:    45,
+			IsActive:        false,
+			ScoringStrategy: "ladder",
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
 		},
 	}
 
-	mock.ExpectQuery(`SELECT game_id, game_name, min_players, max_players, instances, is_active, created_at, updated_at FROM games`).
-		WillReturnRows(sqlmock.NewRows([]string{"game_id", "game_name", "min_players", "max_players", "instances", "is_active", "created_at", "updated_at"}).
-			AddRow(expectedGames[0].GameID, expectedGames[0].GameName, expectedGames[0].MinPlayers, expectedGames[0].MaxPlayers, expectedGames[0].Instances, expectedGames[0].IsActive, expectedGames[0].CreatedAt, expectedGames[0].UpdatedAt).
-			AddRow(expectedGames[1].GameID, expectedGames[1].GameName, expectedGames[1].MinPlayers, expectedGames[1].MaxPlayers, expectedGames[1].Instances, expectedGames[1].IsActive, expectedGames[1].CreatedAt, expectedGames[1].UpdatedAt))
+	mock.ExpectQuery(`SELECT game_id, game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy, created_at, updated_at FROM games`).
+		WillReturnRows(sqlmock.NewRows([]string{"game_id", "game_name", "min_players", "max_players", "instances", "open_time", "close_time", "slot_duration", "weekday_schedules", "is_active", "scoring_strategy", "created_at", "updated_at"}).
+			AddRow(expectedGames[0].GameID, expectedGames[0].GameName, expectedGames[0].MinPlayers, expectedGames[0].MaxPlayers, expectedGames[0].Instances, expectedGames[0].OpenTime, expectedGames[0].CloseTime, expectedGames[0].SlotDuration, nil, expectedGames[0].IsActive, expectedGames[0].ScoringStrategy, expectedGames[0].CreatedAt, expectedGames[0].UpdatedAt).
+			AddRow(expectedGames[1].GameID, expectedGames[1].GameName, expectedGames[1].MinPlayers, expectedGames[1].MaxPlayers, expectedGames[1].Instances, expectedGames[1].OpenTime, expectedGames[1].CloseTime, expectedGames[1].SlotDuration, nil, expectedGames[1].IsActive, expectedGames[1].ScoringStrategy, expectedGames[1].CreatedAt, expectedGames[1].UpdatedAt))
 
 	repo := repositories.NewGameRepo(db)
 	ctx := context.Background()
@@ -101,17 +116,21 @@ func TestGameRepo_CreateGame(t *testing.T) {
 	defer db.Close()
 
 	game := &entities.Game{
-		GameName:   "New Game",
-		MinPlayers: 2,
-		MaxPlayers: 4,
-		Instances:  1,
-		IsActive:   true,
+		GameName:        "New Game",
+		MinPlayers:      2,
+		MaxPlayers:      4,
+		Instances:       1,
+		OpenTime:        "09:00",
+		CloseTime:       "18:00",
+		SlotDuration:    20,
+		IsActive:        true,
+		ScoringStrategy: "points",
 	}
 
 	gameID := uuid.New()
 
-	mock.ExpectQuery(`INSERT INTO games \(game_name, min_players, max_players, instances, is_active\) VALUES \(\$1, \$2, \$3, \$4, \$5\) RETURNING game_id`).
-		WithArgs(game.GameName, game.MinPlayers, game.MaxPlayers, game.Instances, game.IsActive).
+	mock.ExpectQuery(`INSERT INTO games \(game_name, min_players, max_players, instances, open_time, close_time, slot_duration, weekday_schedules, is_active, scoring_strategy\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10\) RETURNING game_id`).
+		WithArgs(game.GameName, game.MinPlayers, game.MaxPlayers, game.Instances, game.OpenTime, game.CloseTime, game.SlotDuration, sql.NullString{}, game.IsActive, game.ScoringStrategy).
 		WillReturnRows(sqlmock.NewRows([]string{"game_id"}).AddRow(gameID))
 
 	repo := repositories.NewGameRepo(db)
@@ -169,3 +188,50 @@ func TestGameRepo_UpdateGameStatus(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGameRepo_UpdateGameSchedule(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	game := &entities.Game{
+		GameID:       uuid.New(),
+		OpenTime:     "09:00",
+		CloseTime:    "20:00",
+		SlotDuration: 45,
+		WeekdaySchedules: map[time.Weekday]entities.GameSchedule{
+			time.Saturday: {OpenTime: "10:00", CloseTime: "14:00", SlotDuration: 30},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE games SET open_time = \$1, close_time = \$2, slot_duration = \$3, weekday_schedules = \$4, updated_at = CURRENT_TIMESTAMP WHERE game_id = \$5`).
+		WithArgs("09:00", "20:00", 45, sql.NullString{String: `{"6":{"open_time":"10:00","close_time":"14:00","slot_duration":30}}`, Valid: true}, game.GameID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM slots s WHERE s.game_id = \$1 AND s.start_time > NOW\(\) AND NOT EXISTS \((.+)booked.slot_date = s.slot_date\)`).
+		WithArgs(game.GameID).
+		WillReturnResult(sqlmock.NewResult(0, 48))
+	mock.ExpectCommit()
+
+	repo := repositories.NewGameRepo(db)
+	err := repo.UpdateGameSchedule(context.Background(), game)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepo_UpdateScoringStrategy(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	gameID := uuid.New()
+
+	mock.ExpectExec(`UPDATE games SET scoring_strategy = \$1, updated_at = CURRENT_TIMESTAMP WHERE game_id = \$2`).
+		WithArgs("ladder", gameID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	repo := repositories.NewGameRepo(db)
+	err := repo.UpdateScoringStrategy(context.Background(), gameID, "ladder")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
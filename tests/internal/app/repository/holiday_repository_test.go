@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/repositories"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"testing"
+	"time"
+)
+
+func TestCreateHolidays(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewHolidayRepo(db)
+
+	holidays := []entities.Holiday{
+		{Date: time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Christmas"},
+		{Date: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year"},
+	}
+
+	userID := uuid.New()
+	start := time.Date(2030, 12, 25, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO holidays (.+) ON CONFLICT").
+		WithArgs("2030-12-25", "Christmas").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM slots s WHERE s.slot_date = \$1 AND s.start_time > NOW\(\) AND NOT EXISTS`).
+		WithArgs("2030-12-25").
+		WillReturnResult(sqlmock.NewResult(0, 26))
+	mock.ExpectQuery(`SELECT b.user_id, g.game_name, s.start_time FROM bookings b (.+) WHERE s.slot_date = \$1 AND s.start_time > NOW\(\)`).
+		WithArgs("2030-12-25").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "game_name", "start_time"}).AddRow(userID, "Chess", start))
+	mock.ExpectExec("INSERT INTO holidays (.+) ON CONFLICT").
+		WithArgs("2031-01-01", "New Year").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM slots s").
+		WithArgs("2031-01-01").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT b.user_id, g.game_name, s.start_time FROM bookings b").
+		WithArgs("2031-01-01").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "game_name", "start_time"}))
+	mock.ExpectCommit()
+
+	booked, err := repo.CreateHolidays(context.TODO(), holidays)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.HolidayBooking{{UserId: userID, GameName: "Chess", StartTime: start, HolidayName: "Christmas"}}, booked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchHolidaysBetween(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewHolidayRepo(db)
+
+	from := time.Date(2030, 12, 20, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 6)
+	christmas := time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"holiday_date", "name", "created_at"}).AddRow(christmas, "Christmas", from)
+
+	mock.ExpectQuery("SELECT (.+) FROM holidays WHERE holiday_date BETWEEN (.+) AND (.+)").
+		WithArgs("2030-12-20", "2030-12-26").
+		WillReturnRows(rows)
+
+	holidays, err := repo.FetchHolidaysBetween(context.TODO(), from, to)
+	assert.NoError(t, err)
+	assert.Len(t, holidays, 1)
+	assert.Equal(t, "Christmas", holidays[0].Name)
+}
@@ -20,22 +20,26 @@ func TestFetchGameLeaderboard(t *testing.T) {
 	gameID := uuid.New()
 
 	// Mock SQL rows for leaderboard entries
-	rows := sqlmock.NewRows([]string{"username", "score"}).
-		AddRow("john_doe", 100).
-		AddRow("jane_smith", 90)
+	rows := sqlmock.NewRows([]string{"username", "score", "rating", "rating_deviation", "games", "provisional"}).
+		AddRow("john_doe", 100, 1620.5, 80.2, 12, false).
+		AddRow("jane_smith", 90, 1540, 210, 3, true)
 
-	mock.ExpectQuery("SELECT u.username, l.score FROM leaderboard l INNER JOIN users u ON l.user_id = u.user_id WHERE l.game_id =").
-		WithArgs(gameID).
+	mock.ExpectQuery("SELECT u.username, l.score, l.rating, l.rating_deviation, (.+) FROM leaderboard l INNER JOIN users u ON l.user_id = u.user_id WHERE l.game_id = (.+) ORDER BY l.score DESC, l.rating DESC").
+		WithArgs(gameID, 10).
 		WillReturnRows(rows)
 
 	// Execute the method
-	leaderboard, err := repo.FetchGameLeaderboard(context.TODO(), gameID)
+	leaderboard, err := repo.FetchGameLeaderboard(context.TODO(), gameID, 10)
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.Len(t, leaderboard, 2)
 	assert.Equal(t, "john_doe", leaderboard[0].UserName)
 	assert.Equal(t, float64(100), leaderboard[0].Score)
+	assert.Equal(t, 1620.5, leaderboard[0].Rating)
+	assert.Equal(t, 12, leaderboard[0].GamesPlayed)
+	assert.False(t, leaderboard[0].Provisional)
+	assert.True(t, leaderboard[1].Provisional)
 }
 
 func TestFetchUserGameStats(t *testing.T) {
@@ -47,10 +51,10 @@ func TestFetchUserGameStats(t *testing.T) {
 	gameID := uuid.New()
 
 	// Mock row for user game stats
-	row := sqlmock.NewRows([]string{"score_id", "user_id", "game_id", "wins", "losses", "score", "created_at"}).
-		AddRow(uuid.New(), userID, gameID, 5, 2, 200, time.Now())
+	row := sqlmock.NewRows([]string{"score_id", "user_id", "game_id", "wins", "losses", "draws", "score", "rating", "rating_deviation", "volatility", "created_at"}).
+		AddRow(uuid.New(), userID, gameID, 5, 2, 1, 200, 1580.3, 120.4, 0.06, time.Now())
 
-	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, score, created_at FROM leaderboard WHERE user_id =").
+	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE user_id =").
 		WithArgs(userID, gameID).
 		WillReturnRows(row)
 
@@ -61,7 +65,10 @@ func TestFetchUserGameStats(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, userID, stats.UserID)
 	assert.Equal(t, 5, stats.Wins)
+	assert.Equal(t, 1, stats.Draws)
 	assert.Equal(t, float64(200), stats.Score)
+	assert.Equal(t, 1580.3, stats.Rating)
+	assert.Equal(t, 120.4, stats.RatingDeviation)
 }
 
 func TestFetchUserGameStats_NoRows(t *testing.T) {
@@ -73,7 +80,7 @@ func TestFetchUserGameStats_NoRows(t *testing.T) {
 	gameID := uuid.New()
 
 	// No rows found
-	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, score, created_at FROM leaderboard WHERE user_id =").
+	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE user_id =").
 		WithArgs(userID, gameID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -93,11 +100,11 @@ func TestFetchUserOverallStats(t *testing.T) {
 	userID := uuid.New()
 
 	// Mock SQL rows for user stats across all games
-	rows := sqlmock.NewRows([]string{"score_id", "user_id", "game_id", "wins", "losses", "score", "created_at"}).
-		AddRow(uuid.New(), userID, uuid.New(), 10, 3, 300, time.Now()).
-		AddRow(uuid.New(), userID, uuid.New(), 7, 2, 150, time.Now())
+	rows := sqlmock.NewRows([]string{"score_id", "user_id", "game_id", "wins", "losses", "draws", "score", "rating", "rating_deviation", "volatility", "created_at"}).
+		AddRow(uuid.New(), userID, uuid.New(), 10, 3, 0, 300, 1700, 90, 0.059, time.Now()).
+		AddRow(uuid.New(), userID, uuid.New(), 7, 2, 1, 150, 1450, 150, 0.06, time.Now())
 
-	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, score, created_at FROM leaderboard WHERE user_id =").
+	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE user_id =").
 		WithArgs(userID).
 		WillReturnRows(rows)
 
@@ -117,19 +124,23 @@ func TestUpdateUserGameStats_ExistingEntry(t *testing.T) {
 	repo := repositories.NewLeaderboardRepo(db)
 
 	leaderboard := &entities.Leaderboard{
-		ScoreID: uuid.New(),
-		UserID:  uuid.New(),
-		GameID:  uuid.New(),
-		Wins:    10,
-		Losses:  5,
-		Score:   300,
+		ScoreID:         uuid.New(),
+		UserID:          uuid.New(),
+		GameID:          uuid.New(),
+		Wins:            10,
+		Losses:          5,
+		Draws:           2,
+		Score:           300,
+		Rating:          1612.4,
+		RatingDeviation: 95.1,
+		Volatility:      0.06,
 	}
 
 	// Mock check for existing entry
 	mock.ExpectQuery("SELECT EXISTS").WithArgs(leaderboard.ScoreID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 
 	// Mock update query
-	mock.ExpectExec("UPDATE leaderboard SET wins =").WithArgs(leaderboard.Wins, leaderboard.Losses, leaderboard.Score, leaderboard.ScoreID).
+	mock.ExpectExec("UPDATE leaderboard SET wins =").WithArgs(leaderboard.Wins, leaderboard.Losses, leaderboard.Draws, leaderboard.Score, leaderboard.Rating, leaderboard.RatingDeviation, leaderboard.Volatility, leaderboard.ScoreID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Execute the method
@@ -145,19 +156,22 @@ func TestUpdateUserGameStats_NewEntry(t *testing.T) {
 	repo := repositories.NewLeaderboardRepo(db)
 
 	leaderboard := &entities.Leaderboard{
-		ScoreID: uuid.New(),
-		UserID:  uuid.New(),
-		GameID:  uuid.New(),
-		Wins:    8,
-		Losses:  3,
-		Score:   250,
+		ScoreID:         uuid.New(),
+		UserID:          uuid.New(),
+		GameID:          uuid.New(),
+		Wins:            8,
+		Losses:          3,
+		Score:           250,
+		Rating:          1500,
+		RatingDeviation: 350,
+		Volatility:      0.06,
 	}
 
 	// Mock check for non-existing entry
 	mock.ExpectQuery("SELECT EXISTS").WithArgs(leaderboard.ScoreID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 
 	// Mock insert query
-	mock.ExpectExec("INSERT INTO leaderboard").WithArgs(leaderboard.ScoreID, leaderboard.UserID, leaderboard.GameID, leaderboard.Wins, leaderboard.Losses, leaderboard.Score).
+	mock.ExpectExec("INSERT INTO leaderboard").WithArgs(leaderboard.ScoreID, leaderboard.UserID, leaderboard.GameID, leaderboard.Wins, leaderboard.Losses, leaderboard.Draws, leaderboard.Score, leaderboard.Rating, leaderboard.RatingDeviation, leaderboard.Volatility).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Execute the method
@@ -166,3 +180,80 @@ func TestUpdateUserGameStats_NewEntry(t *testing.T) {
 	// Assertions
 	assert.NoError(t, err)
 }
+
+func TestFetchGameStats(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewLeaderboardRepo(db)
+
+	gameID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"score_id", "user_id", "game_id", "wins", "losses", "draws", "score", "rating", "rating_deviation", "volatility", "created_at"}).
+		AddRow(uuid.New(), uuid.New(), gameID, 6, 1, 0, 2, 1650, 90, 0.06, time.Now()).
+		AddRow(uuid.New(), uuid.New(), gameID, 1, 6, 0, 1, 1380, 90, 0.06, time.Now())
+
+	mock.ExpectQuery("SELECT score_id, user_id, game_id, wins, losses, draws, score, rating, rating_deviation, volatility, created_at FROM leaderboard WHERE game_id = (.+) ORDER BY score DESC").
+		WithArgs(gameID).
+		WillReturnRows(rows)
+
+	stats, err := repo.FetchGameStats(context.TODO(), gameID)
+
+	assert.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, 6, stats[0].Wins)
+	assert.Equal(t, float64(1), stats[1].Score)
+}
+
+func TestFetchMatchHistory(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewLeaderboardRepo(db)
+
+	gameID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+
+	rows := sqlmock.NewRows([]string{"match_id", "user_id", "booking_id", "side", "outcome"}).
+		AddRow(first, alice, uuid.New(), 1, "win").
+		AddRow(first, bob, uuid.New(), 2, "loss").
+		AddRow(second, alice, uuid.New(), 1, "draw").
+		AddRow(second, bob, uuid.New(), 2, "draw")
+
+	mock.ExpectQuery("SELECT mp.match_id, mp.user_id, mp.booking_id, mp.side, mp.outcome FROM matches m (.+) WHERE s.game_id = (.+) AND m.applied_at IS NOT NULL ORDER BY m.applied_at").
+		WithArgs(gameID).
+		WillReturnRows(rows)
+
+	history, err := repo.FetchMatchHistory(context.TODO(), gameID)
+
+	assert.NoError(t, err)
+	assert.Len(t, history, 2)
+	assert.Len(t, history[0], 2)
+	assert.Equal(t, first, history[0][0].MatchID)
+	assert.Equal(t, "win", history[0][0].Outcome)
+	assert.Equal(t, bob, history[1][1].UserID)
+	assert.Equal(t, "draw", history[1][1].Outcome)
+}
+
+func TestUpdateScores(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewLeaderboardRepo(db)
+
+	leaderboard := []*entities.Leaderboard{
+		{ScoreID: uuid.New(), Score: 2},
+		{ScoreID: uuid.New(), Score: 1},
+	}
+
+	mock.ExpectBegin()
+	for _, entry := range leaderboard {
+		mock.ExpectExec("UPDATE leaderboard SET score = (.+) WHERE score_id =").
+			WithArgs(entry.Score, entry.ScoreID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	err := repo.UpdateScores(context.TODO(), leaderboard)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/repositories"
+	"project2/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestCreateRecurringBooking(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewRecurringBookingRepo(db)
+
+	recurrence := &entities.RecurringBooking{
+		UserID:    uuid.New(),
+		GameID:    uuid.New(),
+		Weekdays:  []time.Weekday{time.Tuesday, time.Thursday},
+		StartTime: "13:00",
+		EndDate:   time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	recurrenceID := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO recurring_bookings").
+		WithArgs(recurrence.UserID, recurrence.GameID, pq.Array([]int64{2, 4}), "13:00", "2030-01-31").
+		WillReturnRows(sqlmock.NewRows([]string{"recurrence_id"}).AddRow(recurrenceID))
+
+	id, err := repo.CreateRecurringBooking(context.TODO(), recurrence)
+	assert.NoError(t, err)
+	assert.Equal(t, recurrenceID, id)
+}
+
+func TestFetchRecurringBookingsByUserID(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewRecurringBookingRepo(db)
+
+	userID := uuid.New()
+	endDate := time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"recurrence_id", "game_name", "weekdays", "start_time", "end_date"}).
+		AddRow(uuid.New(), "Carrom", "{2,4}", "13:00", endDate)
+
+	mock.ExpectQuery("SELECT (.+) FROM recurring_bookings r JOIN games g ON r.game_id = g.game_id WHERE r.user_id = ?").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	recurrences, err := repo.FetchRecurringBookingsByUserID(context.TODO(), userID)
+	assert.NoError(t, err)
+	assert.Len(t, recurrences, 1)
+	assert.Equal(t, "Carrom", recurrences[0].GameName)
+	assert.Equal(t, []time.Weekday{time.Tuesday, time.Thursday}, recurrences[0].Weekdays)
+}
+
+func TestFetchActiveRecurringBookingsByGameID(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewRecurringBookingRepo(db)
+
+	gameID := uuid.New()
+	date := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"recurrence_id", "user_id", "game_id", "weekdays", "start_time", "end_date", "created_at"}).
+		AddRow(uuid.New(), uuid.New(), gameID, "{1}", "13:00", date.AddDate(0, 1, 0), time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM recurring_bookings WHERE game_id = (.+) AND end_date >= ?").
+		WithArgs(gameID, "2030-01-07").
+		WillReturnRows(rows)
+
+	recurrences, err := repo.FetchActiveRecurringBookingsByGameID(context.TODO(), gameID, date)
+	assert.NoError(t, err)
+	assert.Len(t, recurrences, 1)
+	assert.Equal(t, []time.Weekday{time.Monday}, recurrences[0].Weekdays)
+}
+
+func TestDeleteRecurringBooking(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewRecurringBookingRepo(db)
+
+	recurrenceID := uuid.New()
+	userID := uuid.New()
+
+	mock.ExpectExec("DELETE FROM recurring_bookings WHERE recurrence_id = (.+) AND user_id = ?").
+		WithArgs(recurrenceID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.DeleteRecurringBooking(context.TODO(), recurrenceID, userID)
+	assert.NoError(t, err)
+
+	mock.ExpectExec("DELETE FROM recurring_bookings WHERE recurrence_id = (.+) AND user_id = ?").
+		WithArgs(recurrenceID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.DeleteRecurringBooking(context.TODO(), recurrenceID, userID)
+	assert.Error(t, err)
+}
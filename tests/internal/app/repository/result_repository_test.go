@@ -0,0 +1,381 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/repositories"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"testing"
+	"time"
+)
+
+func TestCreateMatch(t *testing.T) {
+	score := 21
+	reporterID := uuid.New()
+	match := &entities.Match{
+		SlotID:     uuid.New(),
+		ReportedBy: reporterID,
+		ConfirmBy:  time.Date(2030, 1, 11, 9, 0, 0, 0, time.UTC),
+		Players: []entities.MatchPlayer{
+			{UserID: reporterID, BookingID: uuid.New(), Side: 1, Outcome: "win", Score: &score},
+			{UserID: uuid.New(), BookingID: uuid.New(), Side: 2, Outcome: "loss"},
+		},
+	}
+
+	t.Run("stores the match with its players and asks the other players to confirm it", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+		matchID := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO matches").
+			WithArgs(match.SlotID, match.ReportedBy, match.ConfirmBy).
+			WillReturnRows(sqlmock.NewRows([]string{"match_id"}).AddRow(matchID))
+		mock.ExpectExec("INSERT INTO match_players").
+			WithArgs(matchID, match.Players[0].UserID, match.Players[0].BookingID, 1, "win", sql.NullInt64{Int64: 21, Valid: true}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO match_players").
+			WithArgs(matchID, match.Players[1].UserID, match.Players[1].BookingID, 2, "loss", sql.NullInt64{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO result_confirmations").
+			WithArgs(matchID, match.ReportedBy).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		id, err := repo.CreateMatch(context.TODO(), match)
+		assert.NoError(t, err)
+		assert.Equal(t, matchID, id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a second match for the same slot", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO matches").
+			WithArgs(match.SlotID, match.ReportedBy, match.ConfirmBy).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err := repo.CreateMatch(context.TODO(), match)
+		assert.ErrorIs(t, err, domain_errors.ErrResultAlreadyReported)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestFetchMatchByID(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewResultRepo(db)
+
+	matchID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT match_id, slot_id, reported_by, status, confirm_by, resolved_at, applied_at, created_at FROM matches").
+		WithArgs(matchID).
+		WillReturnRows(sqlmock.NewRows([]string{"match_id", "slot_id", "reported_by", "status", "confirm_by", "resolved_at", "applied_at", "created_at"}).
+			AddRow(matchID, uuid.New(), uuid.New(), "pending", now, nil, nil, now))
+	mock.ExpectQuery("SELECT user_id, booking_id, side, outcome, score FROM match_players").
+		WithArgs(matchID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "booking_id", "side", "outcome", "score"}).
+			AddRow(uuid.New(), uuid.New(), 1, "draw", 2).
+			AddRow(uuid.New(), uuid.New(), 2, "draw", nil))
+
+	match, err := repo.FetchMatchByID(context.TODO(), matchID)
+	assert.NoError(t, err)
+	assert.Len(t, match.Players, 2)
+	assert.Equal(t, 2, *match.Players[0].Score)
+	assert.Nil(t, match.Players[1].Score)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRespondToResult(t *testing.T) {
+	matchID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("confirms the result with the last confirmation", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status FROM matches WHERE match_id = (.+) FOR UPDATE").
+			WithArgs(matchID).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("pending"))
+		mock.ExpectExec("UPDATE result_confirmations SET status = ?").
+			WithArgs(matchID, userID, "confirmed").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM result_confirmations").
+			WithArgs(matchID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("UPDATE matches SET status = 'confirmed'").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		status, err := repo.RespondToResult(context.TODO(), matchID, userID, true)
+		assert.NoError(t, err)
+		assert.Equal(t, "confirmed", status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps the result pending while other players have not responded", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status FROM matches WHERE match_id = (.+) FOR UPDATE").
+			WithArgs(matchID).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("pending"))
+		mock.ExpectExec("UPDATE result_confirmations SET status = ?").
+			WithArgs(matchID, userID, "confirmed").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT COUNT(.+) FROM result_confirmations").
+			WithArgs(matchID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectCommit()
+
+		status, err := repo.RespondToResult(context.TODO(), matchID, userID, true)
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("marks the result as disputed", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status FROM matches WHERE match_id = (.+) FOR UPDATE").
+			WithArgs(matchID).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("pending"))
+		mock.ExpectExec("UPDATE result_confirmations SET status = ?").
+			WithArgs(matchID, userID, "disputed").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE matches SET status = 'disputed'").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		status, err := repo.RespondToResult(context.TODO(), matchID, userID, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "disputed", status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a response to a result that is no longer pending", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status FROM matches WHERE match_id = (.+) FOR UPDATE").
+			WithArgs(matchID).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("disputed"))
+		mock.ExpectRollback()
+
+		_, err := repo.RespondToResult(context.TODO(), matchID, userID, true)
+		assert.ErrorIs(t, err, domain_errors.ErrNoResultToConfirm)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestResolveDispute(t *testing.T) {
+	matchID := uuid.New()
+	players := []entities.MatchPlayer{
+		{UserID: uuid.New(), BookingID: uuid.New(), Side: 1, Outcome: "draw"},
+		{UserID: uuid.New(), BookingID: uuid.New(), Side: 2, Outcome: "draw"},
+	}
+
+	t.Run("replaces the reported outcomes", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE matches SET status = 'confirmed'").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM match_players WHERE match_id = ?").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		for _, player := range players {
+			mock.ExpectExec("INSERT INTO match_players").
+				WithArgs(matchID, player.UserID, player.BookingID, player.Side, "draw", sql.NullInt64{}).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+
+		err := repo.ResolveDispute(context.TODO(), matchID, players)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a match that is not disputed", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE matches SET status = 'confirmed'").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.ResolveDispute(context.TODO(), matchID, players)
+		assert.ErrorIs(t, err, domain_errors.ErrResultNotDisputed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestConfirmExpiredResults(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewResultRepo(db)
+
+	deadline := time.Date(2030, 1, 11, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE matches SET status = 'confirmed'").
+		WithArgs(deadline).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	confirmed, err := repo.ConfirmExpiredResults(context.TODO(), deadline)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, confirmed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchUnappliedMatchIDs(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewResultRepo(db)
+
+	matchID := uuid.New()
+
+	mock.ExpectQuery("SELECT match_id FROM matches WHERE status = 'confirmed' AND applied_at IS NULL").
+		WillReturnRows(sqlmock.NewRows([]string{"match_id"}).AddRow(matchID))
+
+	matchIDs, err := repo.FetchUnappliedMatchIDs(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{matchID}, matchIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyMatch(t *testing.T) {
+	matchID, gameID := uuid.New(), uuid.New()
+	scoreID, userID := uuid.New(), uuid.New()
+	teamID, alice, carol := uuid.New(), uuid.New(), uuid.New()
+	statsColumns := []string{"score_id", "user_id", "game_id", "wins", "losses", "draws", "score", "rating", "rating_deviation", "volatility", "created_at"}
+
+	// scoreWin counts a win for the only player of the locked standings
+	scoreWin := func(standings []*entities.Leaderboard) ([]*entities.Leaderboard, error) {
+		standings[0].Wins++
+		standings[0].Rating, standings[0].Score = 1520, 1520
+		return standings, nil
+	}
+	teamColumns := []string{"team_id", "game_id", "name", "wins", "losses", "draws", "rating", "rating_deviation", "volatility", "created_at", "members"}
+
+	// rateWin counts a win for the only team of the locked teams
+	rateWin := func(teams []entities.Team) ([]*entities.Team, error) {
+		teams[0].Wins++
+		teams[0].Rating = 1581.4
+		return []*entities.Team{&teams[0]}, nil
+	}
+
+	t.Run("should score the locked player and team stats and save them with the booking results", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE matches SET applied_at = NOW\\(\\) WHERE match_id = (.+) AND status = 'confirmed' AND applied_at IS NULL").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SELECT game_id FROM games WHERE game_id = (.+) FOR UPDATE").
+			WithArgs(gameID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// Another match of the game was applied while this one was confirmed, so the player already has 3 wins
+		mock.ExpectQuery("SELECT score_id, (.+) FROM leaderboard WHERE game_id = (.+) FOR UPDATE").
+			WithArgs(gameID).
+			WillReturnRows(sqlmock.NewRows(statsColumns).AddRow(scoreID, userID, gameID, 3, 0, 0, 1510.0, 1510.0, 180.0, 0.06, time.Now()))
+		mock.ExpectExec("SELECT team_id FROM teams WHERE game_id = (.+) ORDER BY team_id FOR UPDATE").
+			WithArgs(gameID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// The team won another match that was applied in the meantime as well
+		mock.ExpectQuery("SELECT t.team_id, (.+) FROM teams t (.+) WHERE t.game_id = (.+) GROUP BY t.team_id").
+			WithArgs(gameID).
+			WillReturnRows(sqlmock.NewRows(teamColumns).
+				AddRow(teamID, gameID, "Table Sharks", 4, 1, 0, 1570.0, 170.3, 0.06, time.Now(), "{"+alice.String()+","+carol.String()+"}"))
+		mock.ExpectExec("INSERT INTO leaderboard (.+) ON CONFLICT \\(user_id, game_id\\) DO UPDATE").
+			WithArgs(scoreID, userID, gameID, 4, 0, 0, 1520.0, 1520.0, 180.0, 0.06).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE teams SET wins = (.+), losses = (.+), draws = (.+), rating = (.+), rating_deviation = (.+), volatility = (.+) WHERE team_id = (.+)").
+			WithArgs(5, 1, 0, 1581.4, 170.3, 0.06, teamID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE bookings b SET result = p.outcome FROM match_players p").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.ApplyMatch(context.TODO(), matchID, gameID, scoreWin, rateWin)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should leave a match that was already applied untouched", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE matches SET applied_at = NOW\\(\\)").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.ApplyMatch(context.TODO(), matchID, gameID, scoreWin, rateWin)
+		assert.ErrorIs(t, err, domain_errors.ErrResultAlreadyApplied)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should roll back the applied mark when the stats cannot be saved", func(t *testing.T) {
+		db, mock := setup()
+		defer db.Close()
+		repo := repositories.NewResultRepo(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE matches SET applied_at = NOW\\(\\)").
+			WithArgs(matchID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SELECT game_id FROM games").
+			WithArgs(gameID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT score_id, (.+) FROM leaderboard").
+			WithArgs(gameID).
+			WillReturnRows(sqlmock.NewRows(statsColumns).AddRow(scoreID, userID, gameID, 3, 0, 0, 1510.0, 1510.0, 180.0, 0.06, time.Now()))
+		mock.ExpectExec("SELECT team_id FROM teams").
+			WithArgs(gameID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT t.team_id, (.+) FROM teams t").
+			WithArgs(gameID).
+			WillReturnRows(sqlmock.NewRows(teamColumns).
+				AddRow(teamID, gameID, "Table Sharks", 4, 1, 0, 1570.0, 170.3, 0.06, time.Now(), "{"+alice.String()+","+carol.String()+"}"))
+		mock.ExpectExec("INSERT INTO leaderboard").
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := repo.ApplyMatch(context.TODO(), matchID, gameID, scoreWin, rateWin)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"project2/internal/app/repositories"
 	"project2/internal/domain/entities"
+	"project2/internal/models"
 	"testing"
 	"time"
 )
@@ -19,10 +21,10 @@ func TestFetchSlotByID(t *testing.T) {
 	slotRepo := repositories.NewSlotRepo(db)
 	slotID := uuid.New()
 
-	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
-		AddRow(slotID, uuid.New(), time.Now(), time.Now(), time.Now().Add(20*time.Minute), false, time.Now())
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
+		AddRow(slotID, uuid.New(), 1, time.Now(), time.Now(), time.Now().Add(20*time.Minute), false, time.Now())
 
-	mock.ExpectQuery(`SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_id = \$1`).
+	mock.ExpectQuery(`SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_id = \$1`).
 		WithArgs(slotID).
 		WillReturnRows(rows)
 
@@ -47,7 +49,7 @@ func TestCreateSlot(t *testing.T) {
 	}
 
 	slotID := uuid.New()
-	mock.ExpectQuery(`INSERT INTO slots`).WithArgs(slot.GameID, slot.Date, slot.StartTime, slot.EndTime, slot.IsBooked).
+	mock.ExpectQuery(`INSERT INTO slots`).WithArgs(slot.GameID, slot.Instance, slot.Date, slot.StartTime, slot.EndTime, slot.IsBooked).
 		WillReturnRows(sqlmock.NewRows([]string{"slot_id"}).AddRow(slotID))
 
 	id, err := slotRepo.CreateSlot(ctx, slot)
@@ -55,6 +57,29 @@ func TestCreateSlot(t *testing.T) {
 	assert.Equal(t, slotID, id)
 }
 
+func TestCreateSlot_AlreadyExists(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	ctx := context.TODO()
+	slotRepo := repositories.NewSlotRepo(db)
+	slot := &entities.Slot{
+		GameID:    uuid.New(),
+		Instance:  1,
+		Date:      time.Now(),
+		StartTime: time.Now(),
+		EndTime:   time.Now().Add(20 * time.Minute),
+	}
+
+	mock.ExpectQuery(`INSERT INTO slots (.+) ON CONFLICT \(game_id, start_time, instance\) DO NOTHING`).
+		WithArgs(slot.GameID, slot.Instance, slot.Date, slot.StartTime, slot.EndTime, slot.IsBooked).
+		WillReturnRows(sqlmock.NewRows([]string{"slot_id"}))
+
+	id, err := slotRepo.CreateSlot(ctx, slot)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.Nil, id)
+}
+
 func TestDeleteSlotByID(t *testing.T) {
 	db, mock := setup()
 	defer db.Close()
@@ -79,10 +104,10 @@ func TestFetchSlotsByDate(t *testing.T) {
 	slotRepo := repositories.NewSlotRepo(db)
 	date := time.Now()
 
-	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
-		AddRow(uuid.New(), uuid.New(), date, date, date.Add(20*time.Minute), false, time.Now())
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
+		AddRow(uuid.New(), uuid.New(), 1, date, date, date.Add(20*time.Minute), false, time.Now())
 
-	mock.ExpectQuery(`SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = \$1`).
+	mock.ExpectQuery(`SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = \$1`).
 		WithArgs(date.Format("2006-01-02")).
 		WillReturnRows(rows)
 
@@ -100,10 +125,10 @@ func TestFetchSlotByDateAndTime(t *testing.T) {
 	date := time.Now()
 	startTime := time.Now()
 
-	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
-		AddRow(uuid.New(), uuid.New(), date, startTime, startTime.Add(20*time.Minute), false, time.Now())
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
+		AddRow(uuid.New(), uuid.New(), 1, date, startTime, startTime.Add(20*time.Minute), false, time.Now())
 
-	mock.ExpectQuery(`SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = \$1 AND start_time = \$2`).
+	mock.ExpectQuery(`SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE slot_date::date = \$1 AND start_time = \$2`).
 		WithArgs(date.Format("2006-01-02"), startTime).
 		WillReturnRows(rows)
 
@@ -120,10 +145,10 @@ func TestFetchSlotsByGameID(t *testing.T) {
 	slotRepo := repositories.NewSlotRepo(db)
 	gameID := uuid.New()
 
-	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
-		AddRow(uuid.New(), gameID, time.Now(), time.Now(), time.Now().Add(20*time.Minute), false, time.Now())
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
+		AddRow(uuid.New(), gameID, 1, time.Now(), time.Now(), time.Now().Add(20*time.Minute), false, time.Now())
 
-	mock.ExpectQuery(`SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE game_id = \$1`).
+	mock.ExpectQuery(`SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at FROM slots WHERE game_id = \$1`).
 		WithArgs(gameID).
 		WillReturnRows(rows)
 
@@ -141,10 +166,10 @@ func TestFetchSlotsByGameIDAndDate(t *testing.T) {
 	gameID := uuid.New()
 	date := time.Now()
 
-	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
-		AddRow(uuid.New(), gameID, date, date, date.Add(20*time.Minute), false, time.Now())
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
+		AddRow(uuid.New(), gameID, 1, date, date, date.Add(20*time.Minute), false, time.Now())
 
-	mock.ExpectQuery(`SELECT slot_id, game_id, slot_date, start_time, end_time, is_booked, created_at 
+	mock.ExpectQuery(`SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at 
 		FROM slots WHERE game_id = \$1 AND slot_date::date = \$2`).
 		WithArgs(gameID, date.Format("2006-01-02")).
 		WillReturnRows(rows)
@@ -154,6 +179,55 @@ func TestFetchSlotsByGameIDAndDate(t *testing.T) {
 	assert.Len(t, slots, 1)
 }
 
+func TestFetchSlotOccupancyByGameIDAndDate(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	ctx := context.TODO()
+	slotRepo := repositories.NewSlotRepo(db)
+	gameID := uuid.New()
+	date := time.Now()
+
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at", "count", "max_players"}).
+		AddRow(uuid.New(), gameID, 1, date, date, date.Add(20*time.Minute), false, time.Now(), 1, 2).
+		AddRow(uuid.New(), gameID, 2, date, date, date.Add(20*time.Minute), true, time.Now(), 2, 2)
+
+	mock.ExpectQuery(`SELECT s.slot_id, s.game_id, (.+) COUNT\(b.booking_id\), g.max_players FROM slots s`).
+		WithArgs(gameID, date.Format("2006-01-02")).
+		WillReturnRows(rows)
+
+	slots, err := slotRepo.FetchSlotOccupancyByGameIDAndDate(ctx, gameID, date)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 2)
+	assert.Equal(t, 1, slots[0].BookedCount)
+	assert.Equal(t, 2, slots[0].Capacity)
+	assert.True(t, slots[0].IsWaitingForOpponents())
+	assert.True(t, slots[1].IsFull())
+}
+
+func TestFetchSlotOccupancyByDate(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	ctx := context.TODO()
+	slotRepo := repositories.NewSlotRepo(db)
+	date := time.Now()
+
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at", "count", "max_players"}).
+		AddRow(uuid.New(), uuid.New(), 1, date, date, date.Add(20*time.Minute), false, time.Now(), 0, 2).
+		AddRow(uuid.New(), uuid.New(), 1, date, date, date.Add(20*time.Minute), false, time.Now(), 3, 4)
+
+	mock.ExpectQuery(`SELECT s.slot_id, (.+) COUNT\(b.booking_id\), g.max_players FROM slots s (.+) LEFT JOIN bookings b ON b.slot_id = s.slot_id WHERE s.slot_date::date = \$1 GROUP BY s.slot_id`).
+		WithArgs(date.Format("2006-01-02")).
+		WillReturnRows(rows)
+
+	slots, err := slotRepo.FetchSlotOccupancyByDate(ctx, date)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 2)
+	assert.Equal(t, 3, slots[1].BookedCount)
+	assert.Equal(t, 4, slots[1].Capacity)
+}
+
 func TestUpdateSlotStatus(t *testing.T) {
 	db, mock := setup()
 	defer db.Close()
@@ -169,3 +243,51 @@ func TestUpdateSlotStatus(t *testing.T) {
 	err := slotRepo.UpdateSlotStatus(ctx, slotID, true)
 	assert.NoError(t, err)
 }
+
+func TestFetchSlotsStartingBetween(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	ctx := context.TODO()
+	slotRepo := repositories.NewSlotRepo(db)
+	from := time.Now()
+	to := from.Add(15 * time.Minute)
+
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "instance", "slot_date", "start_time", "end_time", "is_booked", "created_at"}).
+		AddRow(uuid.New(), uuid.New(), 1, from, from.Add(10*time.Minute), from.Add(30*time.Minute), false, time.Now())
+
+	mock.ExpectQuery(`SELECT slot_id, game_id, instance, slot_date, start_time, end_time, is_booked, created_at 
+		FROM slots WHERE start_time > \$1 AND start_time <= \$2`).
+		WithArgs(from, to).
+		WillReturnRows(rows)
+
+	slots, err := slotRepo.FetchSlotsStartingBetween(ctx, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 1)
+}
+
+func TestFetchFreeSlots(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+
+	ctx := context.TODO()
+	slotRepo := repositories.NewSlotRepo(db)
+	from := time.Now()
+	to := from.Add(24 * time.Hour)
+	gameID := uuid.New()
+	search := models.SlotSearch{GameIDs: []uuid.UUID{gameID}, EarliestStart: from, LatestStart: to, SeatsNeeded: 2, Limit: 5}
+
+	rows := sqlmock.NewRows([]string{"slot_id", "game_id", "game_name", "instance", "start_time", "end_time", "free_seats"}).
+		AddRow(uuid.New(), gameID, "Carrom", 2, from.Add(time.Hour), from.Add(80*time.Minute), 3)
+
+	mock.ExpectQuery(`SELECT s.slot_id, g.game_id, g.game_name, s.instance, s.start_time, s.end_time, (.+) FROM slots s (.+) AND NOT EXISTS \(SELECT 1 FROM holidays h WHERE h.holiday_date = s.slot_date\)`).
+		WithArgs(from, to, pq.Array([]uuid.UUID{gameID}), 2, 5).
+		WillReturnRows(rows)
+
+	slots, err := slotRepo.FetchFreeSlots(ctx, search)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 1)
+	assert.Equal(t, "Carrom", slots[0].GameName)
+	assert.Equal(t, 3, slots[0].FreeSeats)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
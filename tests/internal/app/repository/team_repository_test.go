@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/app/repositories"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"testing"
+	"time"
+)
+
+func TestCreateTeam(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewTeamRepo(db)
+
+	teamID := uuid.New()
+	team := &entities.Team{
+		GameID:          uuid.New(),
+		Name:            "Table Sharks",
+		CreatedBy:       uuid.New(),
+		Members:         []uuid.UUID{uuid.New(), uuid.New()},
+		Rating:          1500,
+		RatingDeviation: 350,
+		Volatility:      0.06,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO teams \\(game_id, name, created_by, rating, rating_deviation, volatility\\) VALUES (.+) RETURNING team_id").
+		WithArgs(team.GameID, team.Name, team.CreatedBy, team.Rating, team.RatingDeviation, team.Volatility).
+		WillReturnRows(sqlmock.NewRows([]string{"team_id"}).AddRow(teamID))
+	for _, member := range team.Members {
+		mock.ExpectExec("INSERT INTO team_members \\(team_id, user_id\\) VALUES").
+			WithArgs(teamID, member).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	id, err := repo.CreateTeam(context.TODO(), team)
+	assert.NoError(t, err)
+	assert.Equal(t, teamID, id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTeam_NameTaken(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewTeamRepo(db)
+
+	team := &entities.Team{GameID: uuid.New(), Name: "table sharks", CreatedBy: uuid.New(), Members: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	// Another team registered "Table Sharks" after the service checked the names of the game
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO teams").
+		WillReturnError(&pq.Error{Code: "23505", Constraint: "teams_game_name_key"})
+	mock.ExpectRollback()
+
+	_, err := repo.CreateTeam(context.TODO(), team)
+	assert.ErrorIs(t, err, domain_errors.ErrTeamNameTaken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchGameTeams(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewTeamRepo(db)
+
+	gameID := uuid.New()
+	alice, carol := uuid.New(), uuid.New()
+
+	rows := sqlmock.NewRows([]string{"team_id", "game_id", "name", "wins", "losses", "draws", "rating", "rating_deviation", "volatility", "created_at", "members"}).
+		AddRow(uuid.New(), gameID, "Table Sharks", 3, 1, 0, 1560.2, 190.5, 0.06, time.Now(), "{"+alice.String()+","+carol.String()+"}")
+
+	mock.ExpectQuery("SELECT t.team_id, (.+) ARRAY_AGG\\(tm.user_id::text\\) FROM teams t INNER JOIN team_members tm ON tm.team_id = t.team_id WHERE t.game_id = (.+) GROUP BY t.team_id").
+		WithArgs(gameID).
+		WillReturnRows(rows)
+
+	teams, err := repo.FetchGameTeams(context.TODO(), gameID)
+	assert.NoError(t, err)
+	assert.Len(t, teams, 1)
+	assert.Equal(t, "Table Sharks", teams[0].Name)
+	assert.Equal(t, 3, teams[0].Wins)
+	assert.Equal(t, []uuid.UUID{alice, carol}, teams[0].Members)
+}
+
+func TestFetchUserTeams(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewTeamRepo(db)
+
+	userID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"team_id", "name", "game_name", "members", "wins", "draws", "losses", "rating"}).
+		AddRow(uuid.New(), "Table Sharks", "Foosball", pq.StringArray{"alice", "carol"}, 3, 0, 1, 1560.2)
+
+	mock.ExpectQuery("SELECT t.team_id, t.name, g.game_name, (.+) FROM teams t (.+) WHERE t.team_id IN \\(SELECT team_id FROM team_members WHERE user_id = (.+)\\)").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	teams, err := repo.FetchUserTeams(context.TODO(), userID)
+	assert.NoError(t, err)
+	assert.Len(t, teams, 1)
+	assert.Equal(t, "Foosball", teams[0].GameName)
+	assert.Equal(t, []string{"alice", "carol"}, teams[0].Members)
+}
+
+func TestFetchTeamLeaderboard(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewTeamRepo(db)
+
+	gameID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"name", "members", "wins", "draws", "losses", "rating", "rating_deviation"}).
+		AddRow("Table Sharks", "{alice,carol}", 3, 0, 1, 1560.2, 190.5).
+		AddRow("Spinners", "{bob,dave}", 1, 0, 3, 1440.8, 190.5)
+
+	mock.ExpectQuery("SELECT t.name, (.+) FROM teams t (.+) WHERE t.game_id = (.+) AND t.wins \\+ t.draws \\+ t.losses > 0 GROUP BY t.team_id ORDER BY t.rating DESC, t.wins DESC").
+		WithArgs(gameID).
+		WillReturnRows(rows)
+
+	leaderboard, err := repo.FetchTeamLeaderboard(context.TODO(), gameID)
+	assert.NoError(t, err)
+	assert.Len(t, leaderboard, 2)
+	assert.Equal(t, "Table Sharks", leaderboard[0].TeamName)
+	assert.Equal(t, []string{"bob", "dave"}, leaderboard[1].Members)
+}
+
+func TestDeleteTeam(t *testing.T) {
+	db, mock := setup()
+	defer db.Close()
+	repo := repositories.NewTeamRepo(db)
+
+	teamID, userID := uuid.New(), uuid.New()
+
+	t.Run("member disbands the team", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM teams WHERE team_id = (.+) AND EXISTS \\(SELECT 1 FROM team_members WHERE team_id = (.+) AND user_id = (.+)\\)").
+			WithArgs(teamID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.DeleteTeam(context.TODO(), teamID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("non-member cannot disband the team", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM teams WHERE team_id = (.+)").
+			WithArgs(teamID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteTeam(context.TODO(), teamID, userID)
+		assert.ErrorIs(t, err, domain_errors.ErrNotATeamMember)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
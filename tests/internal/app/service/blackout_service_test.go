@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBlackoutService_CreateBlackout(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	start := time.Date(2030, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should reject a window that ends before it starts", func(t *testing.T) {
+		_, err := blackoutService.CreateBlackout(ctx, &entities.Blackout{StartTime: start, EndTime: start})
+		assert.EqualError(t, err, "blackout must end after it starts")
+	})
+
+	t.Run("should reject a window that has already ended", func(t *testing.T) {
+		yesterday := time.Now().AddDate(0, 0, -1)
+		_, err := blackoutService.CreateBlackout(ctx, &entities.Blackout{StartTime: yesterday, EndTime: yesterday.Add(time.Hour)})
+		assert.EqualError(t, err, "blackout has already ended")
+	})
+
+	t.Run("should notify every player whose booking was cancelled", func(t *testing.T) {
+		blackout := &entities.Blackout{StartTime: start, EndTime: start.Add(2 * time.Hour), Reason: " Cleaning "}
+		blackoutID := uuid.New()
+		cancelled := []models.CancelledBooking{
+			{UserId: uuid.New(), GameName: "Chess", StartTime: start},
+			{UserId: uuid.New(), GameName: "Carrom", StartTime: start.Add(time.Hour)},
+		}
+
+		mockBlackoutRepo.EXPECT().CreateBlackout(ctx, blackout).Return(blackoutID, cancelled, nil)
+		for _, booking := range cancelled {
+			mockNotificationService.EXPECT().
+				NotifyUser(ctx, booking.UserId, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, message string) error {
+					assert.True(t, strings.HasSuffix(message, "Reason: Cleaning."))
+					return nil
+				})
+		}
+
+		count, err := blackoutService.CreateBlackout(ctx, blackout)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, blackoutID, blackout.BlackoutID)
+	})
+
+	t.Run("should return the repository error", func(t *testing.T) {
+		blackout := &entities.Blackout{StartTime: start, EndTime: start.Add(time.Hour)}
+		mockBlackoutRepo.EXPECT().CreateBlackout(ctx, blackout).Return(uuid.Nil, nil, errors.New("db error"))
+
+		_, err := blackoutService.CreateBlackout(ctx, blackout)
+		assert.EqualError(t, err, "db error")
+	})
+}
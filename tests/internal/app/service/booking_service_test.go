@@ -6,7 +6,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
 	"project2/internal/models"
 	"testing"
 	"time"
@@ -49,6 +51,33 @@ func TestBookingService_MakeBooking(t *testing.T) {
 		assert.EqualError(t, err, "slot has already passed")
 	})
 
+	t.Run("should fail if the game room is closed for a holiday that day", func(t *testing.T) {
+		slot := &entities.Slot{
+			IsBooked:  false,
+			StartTime: time.Now().Add(time.Hour),
+			GameID:    gameID,
+		}
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("The game room is closed for Diwali", true, nil)
+
+		err := bookingService.MakeBooking(ctx, userID, slotID)
+		assert.ErrorIs(t, err, domain_errors.ErrGameRoomClosed)
+	})
+
+	t.Run("should fail to get game details", func(t *testing.T) {
+		slot := &entities.Slot{
+			IsBooked:  false,
+			StartTime: time.Now().Add(time.Hour),
+			GameID:    gameID,
+		}
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(nil, errors.New("game not found"))
+
+		err := bookingService.MakeBooking(ctx, userID, slotID)
+		assert.EqualError(t, err, "failed to get game details: game not found")
+	})
+
 	t.Run("should fail if user is already booked", func(t *testing.T) {
 		slot := &entities.Slot{
 			IsBooked:  false,
@@ -56,77 +85,250 @@ func TestBookingService_MakeBooking(t *testing.T) {
 			GameID:    gameID,
 		}
 		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
-		mockBookingRepo.EXPECT().FetchBookingBySlotAndUserId(ctx, slotID, userID).Return(models.Bookings{BookingId: uuid.New()}, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 4}, nil)
+		mockBookingRepo.EXPECT().CreateBookingWithinCapacity(ctx, gomock.Any(), 4).Return(uuid.Nil, domain_errors.ErrAlreadyBooked)
 
 		err := bookingService.MakeBooking(ctx, userID, slotID)
 		assert.EqualError(t, err, "user is already booked in this slot")
 	})
 
-	t.Run("should fail to create booking", func(t *testing.T) {
+	t.Run("should fail if slot filled up in the meantime", func(t *testing.T) {
 		slot := &entities.Slot{
 			IsBooked:  false,
 			StartTime: time.Now().Add(time.Hour),
 			GameID:    gameID,
 		}
 		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
-		// Return a booking with a nil UUID, meaning the user hasn't booked this slot yet
-		mockBookingRepo.EXPECT().FetchBookingBySlotAndUserId(ctx, slotID, userID).Return(models.Bookings{BookingId: uuid.Nil}, nil)
-		// Simulate failure in CreateBooking
-		mockBookingRepo.EXPECT().CreateBooking(ctx, gomock.Any()).Return(uuid.New(), errors.New("create booking failed"))
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 4}, nil)
+		mockBookingRepo.EXPECT().CreateBookingWithinCapacity(ctx, gomock.Any(), 4).Return(uuid.Nil, domain_errors.ErrSlotFull)
 
 		err := bookingService.MakeBooking(ctx, userID, slotID)
-		assert.EqualError(t, err, "failed to create booking: create booking failed")
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
 	})
 
-	t.Run("should fail to get game details", func(t *testing.T) {
+	t.Run("should fail if booking overlaps another booking of the user", func(t *testing.T) {
 		slot := &entities.Slot{
 			IsBooked:  false,
 			StartTime: time.Now().Add(time.Hour),
 			GameID:    gameID,
 		}
+		conflict := &domain_errors.BookingOverlapError{BookingID: uuid.New(), GameName: "Pool", StartTime: slot.StartTime, EndTime: slot.StartTime.Add(20 * time.Minute)}
 		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
-		mockBookingRepo.EXPECT().FetchBookingBySlotAndUserId(ctx, slotID, userID).Return(models.Bookings{BookingId: uuid.Nil}, nil)
-		mockBookingRepo.EXPECT().CreateBooking(ctx, gomock.Any()).Return(uuid.New(), nil)
-		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(nil, errors.New("game not found"))
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 4}, nil)
+		mockBookingRepo.EXPECT().CreateBookingWithinCapacity(ctx, gomock.Any(), 4).Return(uuid.Nil, conflict)
 
 		err := bookingService.MakeBooking(ctx, userID, slotID)
-		assert.EqualError(t, err, "failed to get game details: game not found")
+		var overlap *domain_errors.BookingOverlapError
+		assert.ErrorAs(t, err, &overlap)
+		assert.Equal(t, conflict.BookingID, overlap.BookingID)
 	})
 
-	t.Run("should fail to fetch bookings for slot", func(t *testing.T) {
+	t.Run("should fail to create booking", func(t *testing.T) {
 		slot := &entities.Slot{
 			IsBooked:  false,
 			StartTime: time.Now().Add(time.Hour),
 			GameID:    gameID,
 		}
 		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
-		mockBookingRepo.EXPECT().FetchBookingBySlotAndUserId(ctx, slotID, userID).Return(models.Bookings{BookingId: uuid.Nil}, nil)
-		mockBookingRepo.EXPECT().CreateBooking(ctx, gomock.Any()).Return(uuid.New(), nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
 		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 4}, nil)
-		mockBookingRepo.EXPECT().FetchBookingsBySlotID(ctx, slotID).Return(nil, errors.New("failed to fetch bookings"))
+		mockBookingRepo.EXPECT().CreateBookingWithinCapacity(ctx, gomock.Any(), 4).Return(uuid.Nil, errors.New("create booking failed"))
 
 		err := bookingService.MakeBooking(ctx, userID, slotID)
-		assert.EqualError(t, err, "failed to fetch bookings: failed to fetch bookings")
+		assert.EqualError(t, err, "failed to create booking: create booking failed")
 	})
 
-	t.Run("should mark slot as booked when max players reached", func(t *testing.T) {
+	t.Run("should create booking", func(t *testing.T) {
 		slot := &entities.Slot{
 			IsBooked:  false,
 			StartTime: time.Now().Add(time.Hour),
 			GameID:    gameID,
 		}
 		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
-		mockBookingRepo.EXPECT().FetchBookingBySlotAndUserId(ctx, slotID, userID).Return(models.Bookings{BookingId: uuid.Nil}, nil)
-		mockBookingRepo.EXPECT().CreateBooking(ctx, gomock.Any()).Return(uuid.New(), nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
 		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 2}, nil)
-		mockBookingRepo.EXPECT().FetchBookingsBySlotID(ctx, slotID).Return([]entities.Booking{{}, {}}, nil)
-		mockSlotService.EXPECT().MarkSlotAsBooked(ctx, slotID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithinCapacity(ctx, &entities.Booking{SlotID: slotID, UserID: userID}, 2).Return(uuid.New(), nil)
 
 		err := bookingService.MakeBooking(ctx, userID, slotID)
 		assert.NoError(t, err)
 	})
 }
 
+func TestBookingService_MakeGroupBooking(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	organiserID := uuid.New()
+	friendID := uuid.New()
+	colleagueID := uuid.New()
+	slotID := uuid.New()
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: slotID, GameID: gameID, StartTime: time.Now().Add(time.Hour)}
+	game := &entities.Game{GameID: gameID, GameName: "Foosball", MaxPlayers: 4}
+
+	expectParty := func() {
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockUserService.EXPECT().GetUserByID(ctx, organiserID).Return(&entities.User{UserID: organiserID, Username: "organiser"}, nil)
+		mockUserService.EXPECT().GetUserByEmail(ctx, "friend@watchguard.com").Return(&entities.User{UserID: friendID}, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "colleague").Return(&entities.User{UserID: colleagueID}, nil)
+	}
+
+	t.Run("should book the whole party and notify the members", func(t *testing.T) {
+		expectParty()
+		mockBookingRepo.EXPECT().CreateGroupBooking(ctx, slotID, []uuid.UUID{organiserID, friendID, colleagueID}, 4).
+			Return([]uuid.UUID{uuid.New(), uuid.New(), uuid.New()}, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, friendID, gomock.Any()).Return(nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, colleagueID, gomock.Any()).Return(nil)
+
+		err := bookingService.MakeGroupBooking(ctx, organiserID, slotID, []string{"friend@watchguard.com", " colleague", ""})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should name the member whose booking overlaps", func(t *testing.T) {
+		expectParty()
+		overlap := &domain_errors.BookingOverlapError{GameName: "Pool", StartTime: slot.StartTime, EndTime: slot.StartTime.Add(20 * time.Minute)}
+		mockBookingRepo.EXPECT().CreateGroupBooking(ctx, slotID, gomock.Any(), 4).
+			Return(nil, &domain_errors.GroupMemberError{UserID: colleagueID, Err: overlap})
+
+		err := bookingService.MakeGroupBooking(ctx, organiserID, slotID, []string{"friend@watchguard.com", "colleague"})
+		assert.ErrorContains(t, err, "cannot book colleague: booking overlaps your Pool booking")
+	})
+
+	t.Run("should fail when the party does not fit", func(t *testing.T) {
+		expectParty()
+		mockBookingRepo.EXPECT().CreateGroupBooking(ctx, slotID, gomock.Any(), 4).
+			Return(nil, &domain_errors.GroupMemberError{UserID: colleagueID, Err: domain_errors.ErrSlotFull})
+
+		err := bookingService.MakeGroupBooking(ctx, organiserID, slotID, []string{"friend@watchguard.com", "colleague"})
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
+	})
+
+	t.Run("should fail for an unknown member", func(t *testing.T) {
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockUserService.EXPECT().GetUserByID(ctx, organiserID).Return(&entities.User{UserID: organiserID}, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "ghost").Return(nil, errors.New("no such user found"))
+
+		err := bookingService.MakeGroupBooking(ctx, organiserID, slotID, []string{"ghost"})
+		assert.EqualError(t, err, `cannot find user "ghost"`)
+	})
+}
+
+func TestBookingService_CancelBooking(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	userID := uuid.New()
+	slotID := uuid.New()
+	gameID := uuid.New()
+	bookingID := uuid.New()
+	booking := &entities.Booking{BookingID: bookingID, SlotID: slotID, UserID: userID}
+
+	t.Run("should fail if booking does not belong to the user", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(&entities.Booking{BookingID: bookingID, UserID: uuid.New()}, nil)
+
+		err := bookingService.CancelBooking(ctx, userID, bookingID)
+		assert.EqualError(t, err, "booking not found")
+	})
+
+	t.Run("should fail if slot has already started", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(&entities.Slot{SlotID: slotID, StartTime: time.Now().Add(-time.Minute)}, nil)
+
+		err := bookingService.CancelBooking(ctx, userID, bookingID)
+		assert.EqualError(t, err, "cannot cancel a booking for a slot that has already started")
+	})
+
+	t.Run("should fail to delete booking", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(&entities.Slot{SlotID: slotID, GameID: gameID, StartTime: time.Now().Add(time.Hour)}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 2}, nil)
+		mockBookingRepo.EXPECT().CancelBookingAndPromote(ctx, bookingID, 2).Return(uuid.Nil, errors.New("delete failed"))
+
+		err := bookingService.CancelBooking(ctx, userID, bookingID)
+		assert.EqualError(t, err, "failed to cancel booking: delete failed")
+	})
+
+	t.Run("should cancel without notifying anyone when nobody is waiting", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(&entities.Slot{SlotID: slotID, GameID: gameID, StartTime: time.Now().Add(time.Hour)}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{MaxPlayers: 2}, nil)
+		mockBookingRepo.EXPECT().CancelBookingAndPromote(ctx, bookingID, 2).Return(uuid.Nil, nil)
+
+		err := bookingService.CancelBooking(ctx, userID, bookingID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should notify the user promoted from the waitlist", func(t *testing.T) {
+		waiterID := uuid.New()
+		slot := &entities.Slot{SlotID: slotID, GameID: gameID, StartTime: time.Now().Add(time.Hour), IsBooked: true}
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameName: "Chess", MaxPlayers: 2}, nil)
+		mockBookingRepo.EXPECT().CancelBookingAndPromote(ctx, bookingID, 2).Return(waiterID, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, waiterID, gomock.Any()).Return(nil)
+
+		err := bookingService.CancelBooking(ctx, userID, bookingID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestBookingService_JoinWaitlist(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	userID := uuid.New()
+	slotID := uuid.New()
+	slot := &entities.Slot{SlotID: slotID, StartTime: time.Now().Add(time.Hour), IsBooked: true}
+
+	t.Run("should join the waitlist and return the position", func(t *testing.T) {
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockBookingRepo.EXPECT().AddToWaitlist(ctx, &entities.WaitlistEntry{SlotID: slotID, UserID: userID}).Return(nil)
+		mockBookingRepo.EXPECT().FetchWaitlistPosition(ctx, slotID, userID).Return(3, 3, nil)
+
+		position, err := bookingService.JoinWaitlist(ctx, userID, slotID)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, position)
+	})
+
+	t.Run("should reject a slot that still has free seats", func(t *testing.T) {
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockBookingRepo.EXPECT().AddToWaitlist(ctx, gomock.Any()).Return(domain_errors.ErrSlotNotFull)
+
+		_, err := bookingService.JoinWaitlist(ctx, userID, slotID)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotNotFull)
+	})
+
+	t.Run("should reject a slot that has already passed", func(t *testing.T) {
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(&entities.Slot{SlotID: slotID, StartTime: time.Now().Add(-time.Hour)}, nil)
+
+		_, err := bookingService.JoinWaitlist(ctx, userID, slotID)
+		assert.EqualError(t, err, "slot has already passed")
+	})
+}
+
+func TestBookingService_LeaveWaitlist(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	userID := uuid.New()
+	slotID := uuid.New()
+
+	mockBookingRepo.EXPECT().RemoveFromWaitlist(gomock.Any(), slotID, userID).Return(nil)
+
+	err := bookingService.LeaveWaitlist(context.TODO(), userID, slotID)
+	assert.NoError(t, err)
+}
+
 func TestBookingService_GetUpcomingBookings(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
@@ -237,3 +439,174 @@ func TestBookingService_GetBookingByUserAndSlotID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, booking.BookingId, expectedBooking.BookingId)
 }
+
+func TestBookingService_GetSlotBookingCounts(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// Define inputs
+	slotIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	// Mock return data
+	expectedCounts := map[uuid.UUID]int{slotIDs[0]: 2}
+
+	// Define mocks
+	mockBookingRepo.EXPECT().FetchBookingCountsBySlotIDs(gomock.Any(), slotIDs).Return(expectedCounts, nil)
+
+	// Call the service method
+	counts, err := bookingService.GetSlotBookingCounts(context.TODO(), slotIDs)
+
+	// Assert no error and correct return value
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCounts, counts)
+}
+
+func TestBookingService_CheckIn(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	userID := uuid.New()
+
+	t.Run("should check in within the window around the start time", func(t *testing.T) {
+		mockBookingRepo.EXPECT().CheckInBooking(ctx, userID, "042137", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, from, to time.Time) (uuid.UUID, error) {
+				assert.Equal(t, time.Duration(2*config.CheckInWindowMinutes)*time.Minute, to.Sub(from))
+				assert.True(t, from.Before(time.Now()) && to.After(time.Now()))
+				return uuid.New(), nil
+			})
+
+		err := bookingService.CheckIn(ctx, userID, " 042137\n")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject an empty code", func(t *testing.T) {
+		err := bookingService.CheckIn(ctx, userID, "  ")
+		assert.ErrorIs(t, err, domain_errors.ErrInvalidCheckInCode)
+	})
+
+	t.Run("should return the error for an unknown code", func(t *testing.T) {
+		mockBookingRepo.EXPECT().CheckInBooking(ctx, userID, "000000", gomock.Any(), gomock.Any()).
+			Return(uuid.Nil, domain_errors.ErrInvalidCheckInCode)
+
+		err := bookingService.CheckIn(ctx, userID, "000000")
+		assert.ErrorIs(t, err, domain_errors.ErrInvalidCheckInCode)
+	})
+}
+
+func TestBookingService_TransferBooking(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	userID := uuid.New()
+	friendID := uuid.New()
+	bookingID := uuid.New()
+	slotID := uuid.New()
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: slotID, GameID: gameID, StartTime: time.Now().Add(time.Hour)}
+	game := &entities.Game{GameID: gameID, GameName: "Chess", MaxPlayers: 2}
+
+	t.Run("should hand the seat over and notify both users", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(&entities.Booking{BookingID: bookingID, SlotID: slotID, UserID: userID}, nil)
+		mockUserService.EXPECT().GetUserByEmail(ctx, "friend@watchguard.com").Return(&entities.User{UserID: friendID, Username: "friend"}, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockUserService.EXPECT().GetUserByID(ctx, userID).Return(&entities.User{UserID: userID, Username: "me"}, nil)
+		mockBookingRepo.EXPECT().TransferBooking(ctx, bookingID, friendID).Return(nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, friendID, gomock.Any()).Return(nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, userID, gomock.Any()).Return(nil)
+
+		err := bookingService.TransferBooking(ctx, userID, bookingID, " friend@watchguard.com\n")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not transfer another user's booking", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(&entities.Booking{BookingID: bookingID, SlotID: slotID, UserID: friendID}, nil)
+
+		err := bookingService.TransferBooking(ctx, userID, bookingID, "friend")
+		assert.EqualError(t, err, "booking not found")
+	})
+
+	t.Run("should not transfer a booking to yourself", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(&entities.Booking{BookingID: bookingID, SlotID: slotID, UserID: userID}, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "me").Return(&entities.User{UserID: userID, Username: "me"}, nil)
+
+		err := bookingService.TransferBooking(ctx, userID, bookingID, "me")
+		assert.EqualError(t, err, "cannot transfer a booking to yourself")
+	})
+
+	t.Run("should return the error when the recipient is already in the slot", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(&entities.Booking{BookingID: bookingID, SlotID: slotID, UserID: userID}, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "friend").Return(&entities.User{UserID: friendID, Username: "friend"}, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockUserService.EXPECT().GetUserByID(ctx, userID).Return(&entities.User{UserID: userID, Username: "me"}, nil)
+		mockBookingRepo.EXPECT().TransferBooking(ctx, bookingID, friendID).Return(domain_errors.ErrAlreadyBooked)
+
+		err := bookingService.TransferBooking(ctx, userID, bookingID, "friend")
+		assert.ErrorIs(t, err, domain_errors.ErrAlreadyBooked)
+	})
+}
+
+func TestBookingService_RescheduleBooking(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	userID := uuid.New()
+	waiterID := uuid.New()
+	bookingID := uuid.New()
+	oldSlotID := uuid.New()
+	newSlotID := uuid.New()
+	gameID := uuid.New()
+	oldSlot := &entities.Slot{SlotID: oldSlotID, GameID: gameID, StartTime: time.Now().Add(time.Hour)}
+	newSlot := &entities.Slot{SlotID: newSlotID, GameID: gameID, StartTime: time.Now().Add(2 * time.Hour)}
+	game := &entities.Game{GameID: gameID, GameName: "Chess", MaxPlayers: 2}
+	booking := &entities.Booking{BookingID: bookingID, SlotID: oldSlotID, UserID: userID}
+
+	t.Run("should move the booking and notify the promoted waiter", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, oldSlotID).Return(oldSlot, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, newSlotID).Return(newSlot, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockBookingRepo.EXPECT().RescheduleBooking(ctx, bookingID, newSlotID, 2).Return(uuid.New(), waiterID, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, waiterID, gomock.Any()).Return(nil)
+
+		err := bookingService.RescheduleBooking(ctx, userID, bookingID, newSlotID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep the booking when the new slot is full", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, oldSlotID).Return(oldSlot, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, newSlotID).Return(newSlot, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockBookingRepo.EXPECT().RescheduleBooking(ctx, bookingID, newSlotID, 2).Return(uuid.Nil, uuid.Nil, domain_errors.ErrSlotFull)
+
+		err := bookingService.RescheduleBooking(ctx, userID, bookingID, newSlotID)
+		assert.ErrorIs(t, err, domain_errors.ErrSlotFull)
+	})
+
+	t.Run("should not move a booking to a slot of another game", func(t *testing.T) {
+		otherGameID := uuid.New()
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, oldSlotID).Return(oldSlot, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, newSlotID).Return(&entities.Slot{SlotID: newSlotID, GameID: otherGameID, StartTime: newSlot.StartTime}, nil)
+		mockHolidayService.EXPECT().GetClosure(ctx, gomock.Any()).Return("", false, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, otherGameID).Return(&entities.Game{GameID: otherGameID, GameName: "Carrom", MaxPlayers: 4}, nil)
+
+		err := bookingService.RescheduleBooking(ctx, userID, bookingID, newSlotID)
+		assert.EqualError(t, err, "a booking can only be moved to another Carrom slot")
+	})
+
+	t.Run("should not reschedule a slot that has already started", func(t *testing.T) {
+		mockBookingRepo.EXPECT().FetchBookingByID(ctx, bookingID).Return(booking, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, oldSlotID).Return(&entities.Slot{SlotID: oldSlotID, GameID: gameID, StartTime: time.Now().Add(-time.Minute)}, nil)
+
+		err := bookingService.RescheduleBooking(ctx, userID, bookingID, newSlotID)
+		assert.EqualError(t, err, "cannot reschedule a booking for a slot that has already started")
+	})
+}
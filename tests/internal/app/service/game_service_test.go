@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"project2/internal/domain/entities"
+	"project2/pkg/scoring"
 	"testing"
+	"time"
 )
 
 func TestGameService_GetGameByID(t *testing.T) {
@@ -138,6 +140,13 @@ func TestGameService_CreateGame(t *testing.T) {
 			expectedID:    uuid.Nil,
 			expectedError: fmt.Errorf("failed to create game: creation error"),
 		},
+		{
+			name:          "Invalid Schedule",
+			game:          &entities.Game{OpenTime: "18:00", CloseTime: "09:00"},
+			mockSetup:     func() {},
+			expectedID:    uuid.Nil,
+			expectedError: errors.New("opening time must be before closing time"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -240,3 +249,116 @@ func TestUpdateGameStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGameService_CreateGame_DefaultSchedule(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	game := &entities.Game{GameName: "Carrom"}
+	mockGameRepo.EXPECT().CreateGame(gomock.Any(), game).Return(uuid.New(), nil).Times(1)
+
+	_, err := gameService.CreateGame(context.Background(), game)
+	require.NoError(t, err)
+	assert.Equal(t, "09:00", game.OpenTime)
+	assert.Equal(t, "18:00", game.CloseTime)
+	assert.Equal(t, 20, game.SlotDuration)
+	assert.Equal(t, scoring.StrategyRating, game.ScoringStrategy)
+}
+
+func TestGameService_UpdateScoringStrategy(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	gameID := uuid.New()
+
+	t.Run("should save a supported strategy", func(t *testing.T) {
+		mockGameRepo.EXPECT().UpdateScoringStrategy(gomock.Any(), gameID, "ladder").Return(nil)
+
+		err := gameService.UpdateScoringStrategy(context.Background(), gameID, "ladder")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject an unknown strategy", func(t *testing.T) {
+		err := gameService.UpdateScoringStrategy(context.Background(), gameID, "golf")
+		assert.EqualError(t, err, `unknown scoring strategy "golf"`)
+	})
+}
+
+func TestGameService_UpdateGameSchedule(t *testing.T) {
+	validGame := func() *entities.Game {
+		return &entities.Game{GameID: uuid.New(), OpenTime: "09:00", CloseTime: "20:00", SlotDuration: 45}
+	}
+
+	tests := []struct {
+		name          string
+		game          func() *entities.Game
+		mockSetup     func()
+		expectedError string
+	}{
+		{
+			name: "Successful Update",
+			game: validGame,
+			mockSetup: func() {
+				mockGameRepo.EXPECT().UpdateGameSchedule(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Invalid Time Format",
+			game: func() *entities.Game {
+				game := validGame()
+				game.OpenTime = "9am"
+				return game
+			},
+			mockSetup:     func() {},
+			expectedError: "opening and closing times must be in HH:MM format",
+		},
+		{
+			name: "Slot Longer Than Opening Hours",
+			game: func() *entities.Game {
+				game := validGame()
+				game.CloseTime = "09:30"
+				return game
+			},
+			mockSetup:     func() {},
+			expectedError: "slot duration is longer than the opening hours",
+		},
+		{
+			name: "Invalid Weekday Override",
+			game: func() *entities.Game {
+				game := validGame()
+				game.WeekdaySchedules = map[time.Weekday]entities.GameSchedule{
+					time.Saturday: {OpenTime: "10:00", CloseTime: "14:00", SlotDuration: 0},
+				}
+				return game
+			},
+			mockSetup:     func() {},
+			expectedError: "invalid schedule for Saturday: slot duration must be a positive number of minutes",
+		},
+		{
+			name: "Failed to Update Schedule",
+			game: validGame,
+			mockSetup: func() {
+				mockGameRepo.EXPECT().UpdateGameSchedule(gomock.Any(), gomock.Any()).Return(errors.New("update error")).Times(1)
+			},
+			expectedError: "failed to update game schedule: update error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			tt.mockSetup()
+
+			err := gameService.UpdateGameSchedule(context.Background(), tt.game())
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHolidayService_ImportHolidays(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	path := filepath.Join(t.TempDir(), "holidays.csv")
+	err := os.WriteFile(path, []byte("2030-12-25,Christmas\n2031-01-01,New Year\n"), 0o600)
+	assert.NoError(t, err)
+
+	mockHolidayRepo.EXPECT().CreateHolidays(ctx, []entities.Holiday{
+		{Date: time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Christmas"},
+		{Date: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year"},
+	}).Return(nil, nil)
+
+	count, err := holidayService.ImportHolidays(ctx, path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = holidayService.ImportHolidays(ctx, filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err)
+}
+
+func TestHolidayService_AddHoliday(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	christmas := time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	mockHolidayRepo.EXPECT().CreateHolidays(ctx, []entities.Holiday{{Date: christmas, Name: "Christmas"}}).
+		Return([]models.HolidayBooking{{UserId: userID, GameName: "Chess", StartTime: christmas.Add(10 * time.Hour), HolidayName: "Christmas"}}, nil)
+	mockNotificationService.EXPECT().NotifyUser(ctx, userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, message string) error {
+			assert.True(t, strings.HasPrefix(message, "The game room is closed on Wed, 25 Dec for Christmas. Please cancel or reschedule your Chess booking at"))
+			return nil
+		})
+
+	err := holidayService.AddHoliday(ctx, &entities.Holiday{Date: christmas, Name: " Christmas\n"})
+	assert.NoError(t, err)
+}
+
+func TestHolidayService_GetClosure(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	original := config.WorkingDays
+	defer func() { config.WorkingDays = original }()
+	config.WorkingDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	t.Run("should be closed on non-working days", func(t *testing.T) {
+		sunday := time.Date(2030, 12, 22, 0, 0, 0, 0, time.UTC)
+		reason, closed, err := holidayService.GetClosure(ctx, sunday)
+		assert.NoError(t, err)
+		assert.True(t, closed)
+		assert.Equal(t, "The game room is closed on Sundays", reason)
+	})
+
+	t.Run("should be closed on holidays", func(t *testing.T) {
+		christmas := time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)
+		mockHolidayRepo.EXPECT().FetchHolidaysBetween(ctx, christmas, christmas).Return([]entities.Holiday{{Date: christmas, Name: "Christmas"}}, nil)
+
+		reason, closed, err := holidayService.GetClosure(ctx, christmas)
+		assert.NoError(t, err)
+		assert.True(t, closed)
+		assert.Equal(t, "The game room is closed for Christmas", reason)
+	})
+
+	t.Run("should be open on other working days", func(t *testing.T) {
+		monday := time.Date(2030, 12, 23, 0, 0, 0, 0, time.UTC)
+		mockHolidayRepo.EXPECT().FetchHolidaysBetween(ctx, monday, monday).Return(nil, nil)
+
+		_, closed, err := holidayService.GetClosure(ctx, monday)
+		assert.NoError(t, err)
+		assert.False(t, closed)
+	})
+}
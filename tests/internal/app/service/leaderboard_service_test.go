@@ -5,9 +5,10 @@ import (
 	"errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
 	"project2/internal/models"
-	"project2/pkg/utils"
+	"project2/pkg/scoring"
 	"testing"
 )
 
@@ -27,7 +28,7 @@ func TestLeaderboardService_GetGameLeaderboard(t *testing.T) {
 		{
 			name: "Successful Leaderboard Retrieval",
 			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().FetchGameLeaderboard(ctx, gameId).Return(leaderboard, nil)
+				mockLeaderboardRepo.EXPECT().FetchGameLeaderboard(ctx, gameId, config.ProvisionalGames).Return(leaderboard, nil)
 			},
 			expectedError:       false,
 			expectedLeaderboard: leaderboard,
@@ -35,7 +36,7 @@ func TestLeaderboardService_GetGameLeaderboard(t *testing.T) {
 		{
 			name: "Failed Leaderboard Retrieval",
 			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().FetchGameLeaderboard(ctx, gameId).Return(nil, errors.New("error"))
+				mockLeaderboardRepo.EXPECT().FetchGameLeaderboard(ctx, gameId, config.ProvisionalGames).Return(nil, errors.New("error"))
 			},
 			expectedError:       true,
 			expectedLeaderboard: nil,
@@ -62,214 +63,91 @@ func TestLeaderboardService_GetGameLeaderboard(t *testing.T) {
 	}
 }
 
-func TestLeaderboardService_AddWinToUser(t *testing.T) {
+func TestLeaderboardService_ScoreMatch(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
 	ctx := context.TODO()
-	userID := uuid.New()
 	gameID := uuid.New()
-	bookingID := uuid.New()
-
-	mockUserStats := &entities.Leaderboard{
-		UserID: userID,
-		GameID: gameID,
-		Wins:   5,
-		Losses: 2,
-		Score:  100.0,
-	}
-
-	tests := []struct {
-		name          string
-		mockSetup     func()
-		expectedError bool
-	}{
-		{
-			name: "Successfully add win to user and update booking",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(mockUserStats, nil)
-
-				// Increment the wins and update score
-				updatedStats := *mockUserStats
-				updatedStats.Wins++
-				updatedStats.Score = float64(utils.GetTotalScore(updatedStats.Wins, updatedStats.Losses))
-
-				mockLeaderboardRepo.EXPECT().
-					UpdateUserGameStats(ctx, &updatedStats).
-					Return(nil)
-
-				mockBookingService.EXPECT().
-					UpdateBookingResult(ctx, bookingID, "win").
-					Return(nil)
-			},
-			expectedError: false,
-		},
-		{
-			name: "Fail to fetch user game stats",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(nil, errors.New("database error"))
-			},
-			expectedError: true,
-		},
-		{
-			name: "Fail to update user game stats",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(mockUserStats, nil)
-
-				// Simulate failure while updating user stats
-				mockLeaderboardRepo.EXPECT().
-					UpdateUserGameStats(ctx, mockUserStats).
-					Return(errors.New("update error"))
-			},
-			expectedError: true,
-		},
-		{
-			name: "Fail to update booking result",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(mockUserStats, nil)
-
-				updatedStats := *mockUserStats
-				updatedStats.Wins++
-				updatedStats.Score = float64(utils.GetTotalScore(updatedStats.Wins, updatedStats.Losses))
+	winner := entities.MatchPlayer{UserID: uuid.New(), BookingID: uuid.New(), Side: 1, Outcome: entities.OutcomeWin}
+	loser := entities.MatchPlayer{UserID: uuid.New(), BookingID: uuid.New(), Side: 2, Outcome: entities.OutcomeLoss}
+
+	winnerStats := &entities.Leaderboard{UserID: winner.UserID, GameID: gameID, Wins: 2, Rating: 1500, RatingDeviation: 200, Volatility: 0.06}
+
+	mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, ScoringStrategy: scoring.StrategyRating}, nil)
+	updated, err := leaderboardService.ScoreMatch(ctx, gameID, []*entities.Leaderboard{winnerStats}, []entities.MatchPlayer{winner, loser})
+	assert.NoError(t, err)
+	assert.Len(t, updated, 2)
+
+	assert.Equal(t, 3, updated[0].Wins)
+	assert.Greater(t, updated[0].Rating, 1500.0)
+	assert.Equal(t, updated[0].Rating, updated[0].Score)
+	assert.Less(t, updated[0].RatingDeviation, 200.0)
+
+	// the loser had not played the game yet, so they start from the initial rating
+	assert.Equal(t, loser.UserID, updated[1].UserID)
+	assert.Equal(t, 1, updated[1].Losses)
+	assert.Less(t, updated[1].Rating, config.InitialRating)
+	assert.Less(t, updated[1].RatingDeviation, config.InitialRatingDeviation)
+}
 
-				mockLeaderboardRepo.EXPECT().
-					UpdateUserGameStats(ctx, &updatedStats).
-					Return(nil)
+func TestLeaderboardService_ScoreMatch_Ladder(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
 
-				// Simulate failure while updating booking result
-				mockBookingService.EXPECT().
-					UpdateBookingResult(ctx, bookingID, "win").
-					Return(errors.New("booking update error"))
-			},
-			expectedError: true,
-		},
+	ctx := context.TODO()
+	gameID := uuid.New()
+	top := &entities.Leaderboard{ScoreID: uuid.New(), UserID: uuid.New(), GameID: gameID, Wins: 4, Score: 3, Rating: 1600, RatingDeviation: 80, Volatility: 0.06}
+	middle := &entities.Leaderboard{ScoreID: uuid.New(), UserID: uuid.New(), GameID: gameID, Wins: 2, Losses: 2, Score: 2, Rating: 1500, RatingDeviation: 80, Volatility: 0.06}
+	bottom := &entities.Leaderboard{ScoreID: uuid.New(), UserID: uuid.New(), GameID: gameID, Losses: 4, Score: 1, Rating: 1400, RatingDeviation: 80, Volatility: 0.06}
+
+	// The bottom player beats the top player and takes their place, pushing the middle player down
+	match := []entities.MatchPlayer{
+		{UserID: bottom.UserID, BookingID: uuid.New(), Side: 1, Outcome: entities.OutcomeWin},
+		{UserID: top.UserID, BookingID: uuid.New(), Side: 2, Outcome: entities.OutcomeLoss},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			teardown := setup(t)
-			defer teardown()
-
-			tt.mockSetup()
-
-			err := leaderboardService.AddWinToUser(ctx, userID, gameID, bookingID)
-
-			if tt.expectedError {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
+	mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, ScoringStrategy: scoring.StrategyLadder}, nil)
+	updated, err := leaderboardService.ScoreMatch(ctx, gameID, []*entities.Leaderboard{top, middle, bottom}, match)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.Leaderboard{bottom, top, middle}, updated)
+	assert.Equal(t, float64(3), bottom.Score)
+	assert.Equal(t, float64(2), top.Score)
+	assert.Equal(t, float64(1), middle.Score)
 }
 
-func TestLeaderboardService_AddLossToUser(t *testing.T) {
+func TestLeaderboardService_ChangeScoringStrategy(t *testing.T) {
 	ctx := context.TODO()
-	userID := uuid.New()
 	gameID := uuid.New()
-	bookingID := uuid.New()
 
-	mockUserStats := &entities.Leaderboard{
-		UserID: userID,
-		GameID: gameID,
-		Wins:   5,
-		Losses: 2,
-		Score:  100.0,
-	}
+	t.Run("should recompute the scores with the new strategy", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
 
-	tests := []struct {
-		name          string
-		mockSetup     func()
-		expectedError bool
-	}{
-		{
-			name: "Successfully add loss to user and update booking",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(mockUserStats, nil)
+		stats := []*entities.Leaderboard{
+			{ScoreID: uuid.New(), UserID: uuid.New(), GameID: gameID, Wins: 3, Draws: 1, Losses: 2, Score: 40},
+			{ScoreID: uuid.New(), UserID: uuid.New(), GameID: gameID, Wins: 1, Losses: 4, Score: 10},
+		}
 
-				// Increment the losses and update score
-				updatedStats := *mockUserStats
-				updatedStats.Losses++
-				updatedStats.Score = float64(utils.GetTotalScore(updatedStats.Wins, updatedStats.Losses))
+		mockGameService.EXPECT().UpdateScoringStrategy(ctx, gameID, scoring.StrategyPoints).Return(nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, ScoringStrategy: scoring.StrategyPoints}, nil)
+		mockLeaderboardRepo.EXPECT().FetchGameStats(ctx, gameID).Return(stats, nil)
+		mockLeaderboardRepo.EXPECT().FetchMatchHistory(ctx, gameID).Return(nil, nil)
+		mockLeaderboardRepo.EXPECT().UpdateScores(ctx, stats).Return(nil)
 
-				mockLeaderboardRepo.EXPECT().
-					UpdateUserGameStats(ctx, &updatedStats).
-					Return(nil)
+		err := leaderboardService.ChangeScoringStrategy(ctx, gameID, scoring.StrategyPoints)
+		assert.NoError(t, err)
+		assert.Equal(t, 3*config.PointsPerWin+config.PointsPerDraw+2*config.PointsPerLoss, stats[0].Score)
+		assert.Equal(t, config.PointsPerWin+4*config.PointsPerLoss, stats[1].Score)
+	})
 
-				mockBookingService.EXPECT().
-					UpdateBookingResult(ctx, bookingID, "loss").
-					Return(nil)
-			},
-			expectedError: false,
-		},
-		{
-			name: "Fail to fetch user game stats",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(nil, errors.New("database error"))
-			},
-			expectedError: true,
-		},
-		{
-			name: "Fail to update user game stats",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(mockUserStats, nil)
-
-				// Simulate failure while updating user stats
-				mockLeaderboardRepo.EXPECT().
-					UpdateUserGameStats(ctx, mockUserStats).
-					Return(errors.New("update error"))
-			},
-			expectedError: true,
-		},
-		{
-			name: "Fail to update booking result",
-			mockSetup: func() {
-				mockLeaderboardRepo.EXPECT().
-					FetchUserGameStats(ctx, userID, gameID).
-					Return(mockUserStats, nil)
+	t.Run("should not recompute when the strategy cannot be saved", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
 
-				updatedStats := *mockUserStats
-				updatedStats.Losses++
-				updatedStats.Score = float64(utils.GetTotalScore(updatedStats.Wins, updatedStats.Losses))
+		mockGameService.EXPECT().UpdateScoringStrategy(ctx, gameID, "golf").Return(errors.New(`unknown scoring strategy "golf"`))
 
-				mockLeaderboardRepo.EXPECT().
-					UpdateUserGameStats(ctx, &updatedStats).
-					Return(nil)
-
-				// Simulate failure while updating booking result
-				mockBookingService.EXPECT().
-					UpdateBookingResult(ctx, bookingID, "loss").
-					Return(errors.New("booking update error"))
-			},
-			expectedError: true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			teardown := setup(t)
-			defer teardown()
-
-			tt.mockSetup()
-
-			err := leaderboardService.AddLossToUser(ctx, userID, gameID, bookingID)
-
-			if tt.expectedError {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
+		err := leaderboardService.ChangeScoringStrategy(ctx, gameID, "golf")
+		assert.Error(t, err)
+	})
 }
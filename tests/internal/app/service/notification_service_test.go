@@ -68,3 +68,18 @@ func TestNotificationService_GetUserNotifications(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationService_NotifyUser(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	userId := uuid.New()
+	ctx := context.TODO()
+
+	mockNotificationRepo.EXPECT().
+		CreateNotification(ctx, &entities.Notification{UserID: userId, Message: "Test Notification"}).
+		Return(uuid.New(), nil)
+
+	err := notificationService.NotifyUser(ctx, userId, "Test Notification")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"project2/internal/models"
+	"testing"
+	"time"
+)
+
+func TestRecurringBookingService_CreateRecurringBooking(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	gameID := uuid.New()
+	everyDay := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+	t.Run("should reject a recurrence without weekdays", func(t *testing.T) {
+		_, err := recurringService.CreateRecurringBooking(ctx, &entities.RecurringBooking{GameID: gameID, StartTime: "13:00", EndDate: time.Now()})
+		assert.EqualError(t, err, "at least one weekday must be selected")
+	})
+
+	t.Run("should reject an end date in the past", func(t *testing.T) {
+		recurrence := &entities.RecurringBooking{GameID: gameID, Weekdays: everyDay, StartTime: "13:00", EndDate: time.Now().AddDate(0, 0, -1)}
+		_, err := recurringService.CreateRecurringBooking(ctx, recurrence)
+		assert.EqualError(t, err, "end date cannot be in the past")
+	})
+
+	t.Run("should store the recurrence and wait for days without slots", func(t *testing.T) {
+		recurrence := &entities.RecurringBooking{GameID: gameID, Weekdays: everyDay, StartTime: "13:00", EndDate: time.Now().AddDate(0, 1, 0)}
+		recurrenceID := uuid.New()
+
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Carrom"}, nil)
+		mockRecurringRepo.EXPECT().CreateRecurringBooking(ctx, recurrence).Return(recurrenceID, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(nil, nil).Times(config.BookingHorizonDays)
+
+		conflicts, err := recurringService.CreateRecurringBooking(ctx, recurrence)
+		assert.NoError(t, err)
+		assert.Empty(t, conflicts)
+		assert.Equal(t, recurrenceID, recurrence.RecurrenceID)
+	})
+}
+
+func TestRecurringBookingService_MaterialiseRecurringBookings(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	location, _ := time.LoadLocation(config.TimeZone)
+	nextWeek := time.Now().In(location).AddDate(0, 0, 7)
+	startTime := time.Date(nextWeek.Year(), nextWeek.Month(), nextWeek.Day(), 13, 0, 0, 0, location)
+
+	gameID := uuid.New()
+	userID := uuid.New()
+	game := &entities.Game{GameID: gameID, GameName: "Carrom"}
+	firstTable := entities.Slot{SlotID: uuid.New(), GameID: gameID, Instance: 1, StartTime: startTime}
+	secondTable := entities.Slot{SlotID: uuid.New(), GameID: gameID, Instance: 2, StartTime: startTime}
+	otherSlot := entities.Slot{SlotID: uuid.New(), GameID: gameID, Instance: 1, StartTime: startTime.Add(time.Hour)}
+	slots := []entities.Slot{firstTable, secondTable, otherSlot}
+	recurrence := entities.RecurringBooking{UserID: userID, GameID: gameID, Weekdays: []time.Weekday{startTime.Weekday()}, StartTime: "13:00", EndDate: startTime}
+
+	t.Run("should book the next table when the first one is full", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, gomock.Any()).Return(models.Bookings{}, nil).Times(2)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, firstTable.SlotID).Return(domain_errors.ErrSlotFull)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, secondTable.SlotID).Return(nil)
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should notify the user when every table is full", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, gomock.Any()).Return(models.Bookings{}, nil).Times(2)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, gomock.Any()).Return(domain_errors.ErrSlotFull).Times(2)
+		mockNotificationService.EXPECT().NotifyUser(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, message string) error {
+				assert.Contains(t, message, "Carrom")
+				assert.Contains(t, message, "the slot is already full")
+				return nil
+			})
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip users who already hold a seat", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, firstTable.SlotID).Return(models.Bookings{BookingId: uuid.New()}, nil)
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should book into the slots of the day generated by an earlier run", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, gomock.Any()).Return(models.Bookings{}, nil).Times(2)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, firstTable.SlotID).Return(nil)
+
+		// Only the later slot is new, the tables at the recurrence's start time already existed
+		err := recurringService.MaterialiseRecurringBookings(ctx, []entities.Slot{otherSlot})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not report a conflict when the user is already booked", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, gomock.Any()).Return(models.Bookings{}, nil).Times(2)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, firstTable.SlotID).Return(domain_errors.ErrAlreadyBooked)
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not report a conflict when the user took a seat at another table meanwhile", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, gomock.Any()).Return(models.Bookings{}, nil).Times(2)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, firstTable.SlotID).
+			Return(&domain_errors.BookingOverlapError{BookingID: uuid.New(), GameName: "Carrom", StartTime: startTime, EndTime: startTime.Add(20 * time.Minute)})
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should notify the user when the game room is closed that day", func(t *testing.T) {
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{recurrence}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+		mockBookingService.EXPECT().GetBookingByUserAndSlotID(ctx, userID, gomock.Any()).Return(models.Bookings{}, nil).Times(2)
+		mockBookingService.EXPECT().MakeBooking(ctx, userID, firstTable.SlotID).Return(domain_errors.ErrGameRoomClosed)
+		mockNotificationService.EXPECT().NotifyUser(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, message string) error {
+				assert.Contains(t, message, domain_errors.ErrGameRoomClosed.Error())
+				return nil
+			})
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore recurrences on other weekdays", func(t *testing.T) {
+		otherDay := recurrence
+		otherDay.Weekdays = []time.Weekday{(startTime.Weekday() + 1) % 7}
+		mockRecurringRepo.EXPECT().FetchActiveRecurringBookingsByGameID(ctx, gameID, gomock.Any()).Return([]entities.RecurringBooking{otherDay}, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(game, nil)
+		mockSlotService.EXPECT().GetGameSlotsByDate(ctx, gameID, gomock.Any()).Return(slots, nil)
+
+		err := recurringService.MaterialiseRecurringBookings(ctx, slots)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecurringBookingService_DeleteRecurringBooking(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	userID := uuid.New()
+	recurrenceID := uuid.New()
+	mockRecurringRepo.EXPECT().DeleteRecurringBooking(gomock.Any(), recurrenceID, userID).Return(nil)
+
+	err := recurringService.DeleteRecurringBooking(context.TODO(), userID, recurrenceID)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,381 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/config"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	repository_interfaces "project2/internal/domain/interfaces/repository"
+	"project2/internal/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResultService_ReportResult(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(-time.Hour), EndTime: time.Now().Add(-40 * time.Minute)}
+	reporter := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "alice"}
+	opponent := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "bob"}
+	participants := []models.MatchParticipant{reporter, opponent}
+	score := func(value int) *int { return &value }
+
+	t.Run("should store the match and ask the opponent to confirm it", func(t *testing.T) {
+		matchID := uuid.New()
+		players := []entities.MatchPlayer{
+			{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeLoss, Score: score(18)},
+			{UserID: opponent.UserId, Side: 2, Outcome: entities.OutcomeWin, Score: score(21)},
+		}
+
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return(participants, nil)
+		mockResultRepo.EXPECT().CreateMatch(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, match *entities.Match) (uuid.UUID, error) {
+				assert.Equal(t, reporter.UserId, match.ReportedBy)
+				assert.Equal(t, reporter.BookingId, match.Players[0].BookingID)
+				assert.Equal(t, opponent.BookingId, match.Players[1].BookingID)
+				deadline := time.Now().Add(time.Duration(config.ResultConfirmationHours) * time.Hour)
+				assert.WithinDuration(t, deadline, match.ConfirmBy, time.Second)
+				return matchID, nil
+			})
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess"}, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, opponent.UserId, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, message string) error {
+				assert.True(t, strings.Contains(message, "bob beat alice 21–18"))
+				return nil
+			})
+
+		id, err := resultService.ReportResult(ctx, reporter.UserId, slot.SlotID, players)
+		assert.NoError(t, err)
+		assert.Equal(t, matchID, id)
+	})
+
+	t.Run("should reject a match that has not finished", func(t *testing.T) {
+		upcoming := &entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now(), EndTime: time.Now().Add(20 * time.Minute)}
+		mockSlotService.EXPECT().GetSlotByID(ctx, upcoming.SlotID).Return(upcoming, nil)
+
+		_, err := resultService.ReportResult(ctx, reporter.UserId, upcoming.SlotID, nil)
+		assert.EqualError(t, err, "the match has not finished yet")
+	})
+
+	t.Run("should reject a reporter who did not play", func(t *testing.T) {
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return(participants, nil)
+
+		_, err := resultService.ReportResult(ctx, uuid.New(), slot.SlotID, nil)
+		assert.ErrorIs(t, err, domain_errors.ErrNotAParticipant)
+	})
+
+	invalid := []struct {
+		name     string
+		players  []entities.MatchPlayer
+		expected string
+	}{
+		{
+			name:     "a player without an outcome",
+			players:  []entities.MatchPlayer{{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeWin}},
+			expected: "every player of the match needs an outcome",
+		},
+		{
+			name: "two winners",
+			players: []entities.MatchPlayer{
+				{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeWin},
+				{UserID: opponent.UserId, Side: 2, Outcome: entities.OutcomeWin},
+			},
+			expected: "exactly one side must win a match that is not a draw",
+		},
+		{
+			name: "a draw against a win",
+			players: []entities.MatchPlayer{
+				{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeDraw},
+				{UserID: opponent.UserId, Side: 2, Outcome: entities.OutcomeWin},
+			},
+			expected: "either every side draws or none does",
+		},
+		{
+			name: "a single side",
+			players: []entities.MatchPlayer{
+				{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeWin},
+				{UserID: opponent.UserId, Side: 1, Outcome: entities.OutcomeWin},
+			},
+			expected: "a match needs at least two sides",
+		},
+		{
+			name: "a score for one side only",
+			players: []entities.MatchPlayer{
+				{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeWin, Score: score(3)},
+				{UserID: opponent.UserId, Side: 2, Outcome: entities.OutcomeLoss},
+			},
+			expected: "scores must be given for every side or for none",
+		},
+	}
+	for _, test := range invalid {
+		t.Run("should reject "+test.name, func(t *testing.T) {
+			mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+			mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return(participants, nil)
+
+			_, err := resultService.ReportResult(ctx, reporter.UserId, slot.SlotID, test.players)
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestResultService_ReportDoublesResult(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(-time.Hour), EndTime: time.Now().Add(-40 * time.Minute)}
+	alice := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "alice"}
+	bob := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "bob"}
+	carol := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "carol"}
+	dave := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "dave"}
+	participants := []models.MatchParticipant{alice, bob, carol, dave}
+	score := func(value int) *int { return &value }
+
+	t.Run("should credit the outcome of a side to each of its players", func(t *testing.T) {
+		players := []entities.MatchPlayer{
+			{UserID: alice.UserId, Side: 1, Outcome: entities.OutcomeWin, Score: score(10)},
+			{UserID: carol.UserId, Side: 1, Outcome: entities.OutcomeWin, Score: score(10)},
+			{UserID: bob.UserId, Side: 2, Outcome: entities.OutcomeLoss, Score: score(7)},
+			{UserID: dave.UserId, Side: 2, Outcome: entities.OutcomeLoss, Score: score(7)},
+		}
+
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return(participants, nil)
+		mockResultRepo.EXPECT().CreateMatch(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, match *entities.Match) (uuid.UUID, error) {
+				assert.Len(t, match.Players, 4)
+				assert.Equal(t, carol.BookingId, match.Players[1].BookingID)
+				return uuid.New(), nil
+			})
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Foosball"}, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, message string) error {
+				assert.True(t, strings.Contains(message, "alice & carol beat bob & dave 10–7"))
+				return nil
+			}).Times(3)
+
+		_, err := resultService.ReportResult(ctx, alice.UserId, slot.SlotID, players)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject teammates with different outcomes", func(t *testing.T) {
+		players := []entities.MatchPlayer{
+			{UserID: alice.UserId, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: carol.UserId, Side: 1, Outcome: entities.OutcomeLoss},
+			{UserID: bob.UserId, Side: 2, Outcome: entities.OutcomeLoss},
+			{UserID: dave.UserId, Side: 2, Outcome: entities.OutcomeLoss},
+		}
+
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return(participants, nil)
+
+		_, err := resultService.ReportResult(ctx, alice.UserId, slot.SlotID, players)
+		assert.EqualError(t, err, "players on the same side must share its outcome and score")
+	})
+}
+
+func TestResultService_RespondToResult(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(-time.Hour)}
+	winner := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "alice"}
+	loser := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "bob"}
+	match := &entities.Match{
+		MatchID:    uuid.New(),
+		SlotID:     slot.SlotID,
+		ReportedBy: winner.UserId,
+		Players: []entities.MatchPlayer{
+			{UserID: winner.UserId, BookingID: winner.BookingId, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: loser.UserId, BookingID: loser.BookingId, Side: 2, Outcome: entities.OutcomeLoss},
+		},
+	}
+	// the stats of the players as read within the transaction that applies the match
+	current := []*entities.Leaderboard{{UserID: winner.UserId}}
+	standings := []*entities.Leaderboard{{UserID: winner.UserId, Wins: 1}, {UserID: loser.UserId, Losses: 1}}
+	currentTeams := []entities.Team{{TeamID: uuid.New(), Name: "Table Sharks"}}
+	teams := []*entities.Team{{TeamID: currentTeams[0].TeamID, Name: "Table Sharks", Wins: 1}}
+
+	t.Run("should update the leaderboard once the match is confirmed", func(t *testing.T) {
+		mockResultRepo.EXPECT().RespondToResult(ctx, match.MatchID, loser.UserId, true).Return(entities.ResultConfirmed, nil)
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess"}, nil)
+		mockLeaderboardService.EXPECT().ScoreMatch(ctx, gameID, current, match.Players).Return(standings, nil)
+		mockTeamService.EXPECT().RateMatch(ctx, currentTeams, match.Players).Return(teams, nil)
+		mockResultRepo.EXPECT().ApplyMatch(ctx, match.MatchID, gameID, gomock.Any(), gomock.Any()).DoAndReturn(applyWith(t, current, standings, currentTeams, teams, nil))
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return([]models.MatchParticipant{winner, loser}, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		err := resultService.RespondToResult(ctx, loser.UserId, match.MatchID, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should leave a match applied by the confirmation job alone", func(t *testing.T) {
+		mockResultRepo.EXPECT().RespondToResult(ctx, match.MatchID, loser.UserId, true).Return(entities.ResultConfirmed, nil)
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess"}, nil)
+		mockResultRepo.EXPECT().ApplyMatch(ctx, match.MatchID, gameID, gomock.Any(), gomock.Any()).Return(domain_errors.ErrResultAlreadyApplied)
+
+		err := resultService.RespondToResult(ctx, loser.UserId, match.MatchID, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should leave the leaderboard alone while other players have not confirmed", func(t *testing.T) {
+		mockResultRepo.EXPECT().RespondToResult(ctx, match.MatchID, loser.UserId, true).Return(entities.ResultPending, nil)
+
+		err := resultService.RespondToResult(ctx, loser.UserId, match.MatchID, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should tell the reporter about a dispute", func(t *testing.T) {
+		mockResultRepo.EXPECT().RespondToResult(ctx, match.MatchID, loser.UserId, false).Return(entities.ResultDisputed, nil)
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, winner.UserId, gomock.Any()).Return(nil)
+
+		err := resultService.RespondToResult(ctx, loser.UserId, match.MatchID, false)
+		assert.NoError(t, err)
+	})
+}
+
+func TestResultService_ResolveDispute(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+	ctx := context.TODO()
+
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(-time.Hour)}
+	reporter := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "alice"}
+	opponent := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "bob"}
+	participants := []models.MatchParticipant{reporter, opponent}
+	draw := []entities.MatchPlayer{
+		{UserID: reporter.UserId, Side: 1, Outcome: entities.OutcomeDraw},
+		{UserID: opponent.UserId, Side: 2, Outcome: entities.OutcomeDraw},
+	}
+	// the stats of the players as read within the transaction that applies the match
+	current := []*entities.Leaderboard{{UserID: reporter.UserId}}
+	standings := []*entities.Leaderboard{{UserID: reporter.UserId, Draws: 1}, {UserID: opponent.UserId, Draws: 1}}
+	var currentTeams []entities.Team
+	var teams []*entities.Team
+
+	t.Run("should record the outcome decided by the admin", func(t *testing.T) {
+		match := &entities.Match{MatchID: uuid.New(), SlotID: slot.SlotID, ReportedBy: reporter.UserId, Status: entities.ResultDisputed}
+
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return(participants, nil).Times(2)
+		mockResultRepo.EXPECT().ResolveDispute(ctx, match.MatchID, draw).Return(nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess"}, nil)
+		mockLeaderboardService.EXPECT().ScoreMatch(ctx, gameID, current, draw).Return(standings, nil)
+		mockTeamService.EXPECT().RateMatch(ctx, currentTeams, draw).Return(teams, nil)
+		mockResultRepo.EXPECT().ApplyMatch(ctx, match.MatchID, gameID, gomock.Any(), gomock.Any()).DoAndReturn(applyWith(t, current, standings, currentTeams, teams, nil))
+		mockNotificationService.EXPECT().NotifyUser(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		err := resultService.ResolveDispute(ctx, match.MatchID, draw)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject a match that is not disputed", func(t *testing.T) {
+		match := &entities.Match{MatchID: uuid.New(), SlotID: slot.SlotID, Status: entities.ResultConfirmed}
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+
+		err := resultService.ResolveDispute(ctx, match.MatchID, draw)
+		assert.ErrorIs(t, err, domain_errors.ErrResultNotDisputed)
+	})
+}
+
+func TestResultService_ConfirmExpiredResults(t *testing.T) {
+	ctx := context.TODO()
+
+	gameID := uuid.New()
+	slot := &entities.Slot{SlotID: uuid.New(), GameID: gameID, StartTime: time.Now().Add(-48 * time.Hour)}
+	winner := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "alice"}
+	loser := models.MatchParticipant{UserId: uuid.New(), BookingId: uuid.New(), Username: "bob"}
+	match := &entities.Match{
+		MatchID: uuid.New(),
+		SlotID:  slot.SlotID,
+		Status:  entities.ResultConfirmed,
+		Players: []entities.MatchPlayer{
+			{UserID: winner.UserId, BookingID: winner.BookingId, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: loser.UserId, BookingID: loser.BookingId, Side: 2, Outcome: entities.OutcomeLoss},
+		},
+	}
+	// the stats of the players as read within the transaction that applies the match
+	current := []*entities.Leaderboard{{UserID: winner.UserId}}
+	standings := []*entities.Leaderboard{{UserID: winner.UserId, Wins: 1}, {UserID: loser.UserId, Losses: 1}}
+	var currentTeams []entities.Team
+	var teams []*entities.Team
+
+	t.Run("should apply the confirmed matches that are not in the leaderboard yet", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		mockResultRepo.EXPECT().ConfirmExpiredResults(ctx, gomock.Any()).Return(1, nil)
+		mockResultRepo.EXPECT().FetchUnappliedMatchIDs(ctx).Return([]uuid.UUID{match.MatchID}, nil)
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess"}, nil)
+		mockLeaderboardService.EXPECT().ScoreMatch(ctx, gameID, current, match.Players).Return(standings, nil)
+		mockTeamService.EXPECT().RateMatch(ctx, currentTeams, match.Players).Return(teams, nil)
+		mockResultRepo.EXPECT().ApplyMatch(ctx, match.MatchID, gameID, gomock.Any(), gomock.Any()).DoAndReturn(applyWith(t, current, standings, currentTeams, teams, nil))
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return([]models.MatchParticipant{winner, loser}, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		count, err := resultService.ConfirmExpiredResults(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should keep applying the other matches when one fails", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		failing := &entities.Match{MatchID: uuid.New(), SlotID: slot.SlotID, Status: entities.ResultConfirmed, Players: match.Players}
+
+		mockResultRepo.EXPECT().ConfirmExpiredResults(ctx, gomock.Any()).Return(0, nil)
+		mockResultRepo.EXPECT().FetchUnappliedMatchIDs(ctx).Return([]uuid.UUID{failing.MatchID, match.MatchID}, nil)
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, failing.MatchID).Return(failing, nil)
+		mockResultRepo.EXPECT().FetchMatchByID(ctx, match.MatchID).Return(match, nil)
+		mockSlotService.EXPECT().GetSlotByID(ctx, slot.SlotID).Return(slot, nil).Times(2)
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess"}, nil).Times(2)
+		mockLeaderboardService.EXPECT().ScoreMatch(ctx, gameID, current, match.Players).Return(standings, nil).Times(2)
+		mockTeamService.EXPECT().RateMatch(ctx, currentTeams, match.Players).Return(teams, nil).Times(2)
+		mockResultRepo.EXPECT().ApplyMatch(ctx, failing.MatchID, gameID, gomock.Any(), gomock.Any()).DoAndReturn(applyWith(t, current, standings, currentTeams, teams, errors.New("db error")))
+		mockResultRepo.EXPECT().ApplyMatch(ctx, match.MatchID, gameID, gomock.Any(), gomock.Any()).DoAndReturn(applyWith(t, current, standings, currentTeams, teams, nil))
+		mockResultRepo.EXPECT().FetchMatchParticipants(ctx, slot.SlotID).Return([]models.MatchParticipant{winner, loser}, nil)
+		mockNotificationService.EXPECT().NotifyUser(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		count, err := resultService.ConfirmExpiredResults(ctx)
+		assert.ErrorContains(t, err, failing.MatchID.String())
+		assert.Equal(t, 1, count)
+	})
+}
+
+// applyWith stands in for ApplyMatch: it scores the match from the given player stats and teams, as read within
+// the transaction, checks the entries it would save and returns err
+func applyWith(t *testing.T, current, wantStandings []*entities.Leaderboard, currentTeams []entities.Team, wantTeams []*entities.Team, err error) func(context.Context, uuid.UUID, uuid.UUID, repository_interfaces.StandingsScorer, repository_interfaces.TeamRater) error {
+	return func(_ context.Context, _, _ uuid.UUID, scoreStandings repository_interfaces.StandingsScorer, rateTeams repository_interfaces.TeamRater) error {
+		standings, scoreErr := scoreStandings(current)
+		assert.NoError(t, scoreErr)
+		assert.Equal(t, wantStandings, standings)
+		teams, rateErr := rateTeams(currentTeams)
+		assert.NoError(t, rateErr)
+		assert.Equal(t, wantTeams, teams)
+		return err
+	}
+}
@@ -20,6 +20,11 @@ var (
 	mockInvitationRepo   *mock_interfaces.MockInvitationRepository
 	mockBookingRepo      *mock_interfaces.MockBookingRepository
 	mockNotificationRepo *mock_interfaces.MockNotificationRepository
+	mockRecurringRepo    *mock_interfaces.MockRecurringBookingRepository
+	mockBlackoutRepo     *mock_interfaces.MockBlackoutRepository
+	mockHolidayRepo      *mock_interfaces.MockHolidayRepository
+	mockResultRepo       *mock_interfaces.MockResultRepository
+	mockTeamRepo         *mock_interfaces.MockTeamRepository
 
 	mockUserService         *mock_services.MockUserService
 	mockSlotService         *mock_services.MockSlotService
@@ -28,6 +33,8 @@ var (
 	mockInvitationService   *mock_services.MockInvitationService
 	mockBookingService      *mock_services.MockBookingService
 	mockNotificationService *mock_services.MockNotificationService
+	mockTeamService         *mock_services.MockTeamService
+	mockHolidayService      *mock_services.MockHolidayService
 
 	userService         service_interfaces.UserService
 	slotService         service_interfaces.SlotService
@@ -36,6 +43,11 @@ var (
 	invitationService   service_interfaces.InvitationService
 	bookingService      service_interfaces.BookingService
 	notificationService service_interfaces.NotificationService
+	recurringService    service_interfaces.RecurringBookingService
+	blackoutService     service_interfaces.BlackoutService
+	holidayService      service_interfaces.HolidayService
+	resultService       service_interfaces.ResultService
+	teamService         service_interfaces.TeamService
 )
 
 func setup(t *testing.T) func() {
@@ -50,6 +62,11 @@ func setup(t *testing.T) func() {
 	mockInvitationRepo = mock_interfaces.NewMockInvitationRepository(ctrl)
 	mockBookingRepo = mock_interfaces.NewMockBookingRepository(ctrl)
 	mockNotificationRepo = mock_interfaces.NewMockNotificationRepository(ctrl)
+	mockRecurringRepo = mock_interfaces.NewMockRecurringBookingRepository(ctrl)
+	mockBlackoutRepo = mock_interfaces.NewMockBlackoutRepository(ctrl)
+	mockHolidayRepo = mock_interfaces.NewMockHolidayRepository(ctrl)
+	mockResultRepo = mock_interfaces.NewMockResultRepository(ctrl)
+	mockTeamRepo = mock_interfaces.NewMockTeamRepository(ctrl)
 
 	// Create mock services
 	mockUserService = mock_services.NewMockUserService(ctrl)
@@ -59,15 +76,22 @@ func setup(t *testing.T) func() {
 	mockInvitationService = mock_services.NewMockInvitationService(ctrl)
 	mockBookingService = mock_services.NewMockBookingService(ctrl)
 	mockNotificationService = mock_services.NewMockNotificationService(ctrl)
+	mockTeamService = mock_services.NewMockTeamService(ctrl)
+	mockHolidayService = mock_services.NewMockHolidayService(ctrl)
 
 	// Create genuine services
 	userService = services.NewUserService(mockUserRepo)
 	slotService = services.NewSlotService(mockSlotRepo)
 	gameService = services.NewGameService(mockGameRepo)
-	bookingService = services.NewBookingService(mockBookingRepo, mockSlotService, mockGameService)
-	leaderboardService = services.NewLeaderboardService(mockLeaderboardRepo, mockBookingService)
+	bookingService = services.NewBookingService(mockBookingRepo, mockSlotService, mockGameService, mockNotificationService, mockUserService, mockHolidayService)
+	leaderboardService = services.NewLeaderboardService(mockLeaderboardRepo, mockGameService)
 	invitationService = services.NewInvitationService(mockInvitationRepo, mockBookingService, mockSlotService)
 	notificationService = services.NewNotificationService(mockNotificationRepo)
+	recurringService = services.NewRecurringBookingService(mockRecurringRepo, mockBookingService, mockSlotService, mockGameService, mockNotificationService)
+	blackoutService = services.NewBlackoutService(mockBlackoutRepo, mockNotificationService)
+	holidayService = services.NewHolidayService(mockHolidayRepo, mockNotificationService)
+	resultService = services.NewResultService(mockResultRepo, mockSlotService, mockGameService, mockLeaderboardService, mockTeamService, mockNotificationService)
+	teamService = services.NewTeamService(mockTeamRepo, mockUserService, mockGameService)
 
 	// Return a cleanup function to be called at the end of the test
 	return func() {
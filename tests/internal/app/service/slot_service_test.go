@@ -6,7 +6,10 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	"project2/internal/models"
+	"project2/pkg/utils"
 	"testing"
 	"time"
 )
@@ -39,6 +42,82 @@ func TestSlotService_GetCurrentDayGameSlots(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSlotService_GetGameSlotsByDate(t *testing.T) {
+	ctx := context.TODO()
+	gameID := uuid.New()
+	date := time.Now().AddDate(0, 0, 3)
+	slots := []entities.Slot{{SlotID: uuid.New(), GameID: gameID, StartTime: date}}
+
+	teardown := setup(t)
+	defer teardown()
+
+	// Test case: Successful retrieval of the slots of the given day
+	mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(ctx, gameID, date).Return(slots, nil).Times(1)
+
+	returnedSlots, err := slotService.GetGameSlotsByDate(ctx, gameID, date)
+	assert.NoError(t, err)
+	assert.Equal(t, slots, returnedSlots)
+
+	// Test case: Error fetching slots
+	mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(ctx, gameID, date).Return(nil, errors.New("some error")).Times(1)
+
+	_, err = slotService.GetGameSlotsByDate(ctx, gameID, date)
+	assert.Error(t, err)
+}
+
+func TestSlotService_GetJoinableGameSlots(t *testing.T) {
+	ctx := context.TODO()
+	gameID := uuid.New()
+	date := time.Now()
+	later := time.Now().Add(time.Hour)
+
+	empty := models.SlotOccupancy{Slot: entities.Slot{SlotID: uuid.New(), StartTime: later}, BookedCount: 0, Capacity: 2}
+	waiting := models.SlotOccupancy{Slot: entities.Slot{SlotID: uuid.New(), StartTime: later}, BookedCount: 1, Capacity: 4}
+	full := models.SlotOccupancy{Slot: entities.Slot{SlotID: uuid.New(), StartTime: later, IsBooked: true}, BookedCount: 2, Capacity: 2}
+	started := models.SlotOccupancy{Slot: entities.Slot{SlotID: uuid.New(), StartTime: time.Now().Add(-time.Minute)}, BookedCount: 1, Capacity: 2}
+
+	teardown := setup(t)
+	defer teardown()
+
+	// Test case: Only the upcoming partially booked slots are returned
+	mockSlotRepo.EXPECT().FetchSlotOccupancyByGameIDAndDate(ctx, gameID, date).Return([]models.SlotOccupancy{started, empty, waiting, full}, nil).Times(1)
+
+	slots, err := slotService.GetJoinableGameSlots(ctx, gameID, date)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SlotOccupancy{waiting}, slots)
+
+	// Test case: Error fetching slots
+	mockSlotRepo.EXPECT().FetchSlotOccupancyByGameIDAndDate(ctx, gameID, date).Return(nil, errors.New("some error")).Times(1)
+
+	_, err = slotService.GetJoinableGameSlots(ctx, gameID, date)
+	assert.Error(t, err)
+}
+
+func TestSlotService_GetSlotOccupancyByDate(t *testing.T) {
+	ctx := context.TODO()
+	date := time.Now().AddDate(0, 0, 1)
+	slots := []models.SlotOccupancy{
+		{Slot: entities.Slot{SlotID: uuid.New(), GameID: uuid.New(), StartTime: date}, BookedCount: 1, Capacity: 2},
+		{Slot: entities.Slot{SlotID: uuid.New(), GameID: uuid.New(), StartTime: date}, Capacity: 4},
+	}
+
+	teardown := setup(t)
+	defer teardown()
+
+	// Test case: Successful retrieval of the slots of every game
+	mockSlotRepo.EXPECT().FetchSlotOccupancyByDate(ctx, date).Return(slots, nil).Times(1)
+
+	returnedSlots, err := slotService.GetSlotOccupancyByDate(ctx, date)
+	assert.NoError(t, err)
+	assert.Equal(t, slots, returnedSlots)
+
+	// Test case: Error fetching slots
+	mockSlotRepo.EXPECT().FetchSlotOccupancyByDate(ctx, date).Return(nil, errors.New("some error")).Times(1)
+
+	_, err = slotService.GetSlotOccupancyByDate(ctx, date)
+	assert.Error(t, err)
+}
+
 func TestSlotService_GetSlotByID(t *testing.T) {
 	ctx := context.TODO()
 	slotID := uuid.New()
@@ -82,3 +161,71 @@ func TestSlotService_MarkSlotAsBooked(t *testing.T) {
 	err = slotService.MarkSlotAsBooked(ctx, slotID)
 	assert.Error(t, err)
 }
+
+func TestSlotService_MarkSlotAsAvailable(t *testing.T) {
+	ctx := context.TODO()
+	slotID := uuid.New()
+
+	teardown := setup(t)
+	defer teardown()
+
+	// Test case: Successfully reopen the slot
+	mockSlotRepo.EXPECT().UpdateSlotStatus(ctx, slotID, false).Return(nil).Times(1)
+
+	err := slotService.MarkSlotAsAvailable(ctx, slotID)
+	assert.NoError(t, err)
+
+	// Test case: Error reopening the slot
+	mockSlotRepo.EXPECT().UpdateSlotStatus(ctx, slotID, false).Return(errors.New("update failed")).Times(1)
+
+	err = slotService.MarkSlotAsAvailable(ctx, slotID)
+	assert.Error(t, err)
+}
+
+func TestSlotService_FindFreeSlots(t *testing.T) {
+	ctx := context.TODO()
+	teardown := setup(t)
+	defer teardown()
+
+	freeSlots := []models.FreeSlot{{SlotId: uuid.New(), GameName: "Chess", FreeSeats: 2}}
+
+	t.Run("should default to one seat from now until the end of the booking horizon", func(t *testing.T) {
+		horizon := utils.StartOfDay(utils.Now()).AddDate(0, 0, config.BookingHorizonDays)
+		mockSlotRepo.EXPECT().FetchFreeSlots(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, search models.SlotSearch) ([]models.FreeSlot, error) {
+				assert.WithinDuration(t, time.Now(), search.EarliestStart, time.Minute)
+				assert.True(t, search.LatestStart.Equal(horizon))
+				assert.Equal(t, 1, search.SeatsNeeded)
+				assert.Equal(t, config.FreeSlotSearchLimit, search.Limit)
+				return freeSlots, nil
+			})
+
+		slots, err := slotService.FindFreeSlots(ctx, models.SlotSearch{EarliestStart: time.Now().Add(-time.Hour)})
+		assert.NoError(t, err)
+		assert.Equal(t, freeSlots, slots)
+	})
+
+	t.Run("should keep the filters given by the user", func(t *testing.T) {
+		gameID := uuid.New()
+		search := models.SlotSearch{
+			GameIDs:       []uuid.UUID{gameID},
+			EarliestStart: time.Now().Add(time.Hour),
+			LatestStart:   time.Now().Add(3 * time.Hour),
+			SeatsNeeded:   3,
+			Limit:         10,
+		}
+		mockSlotRepo.EXPECT().FetchFreeSlots(ctx, search).Return(freeSlots, nil)
+
+		slots, err := slotService.FindFreeSlots(ctx, search)
+		assert.NoError(t, err)
+		assert.Equal(t, freeSlots, slots)
+	})
+
+	t.Run("should reject a latest start before the earliest start", func(t *testing.T) {
+		_, err := slotService.FindFreeSlots(ctx, models.SlotSearch{
+			EarliestStart: time.Now().Add(2 * time.Hour),
+			LatestStart:   time.Now().Add(time.Hour),
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"project2/internal/domain/entities"
+	domain_errors "project2/internal/domain/errors"
+	"testing"
+)
+
+func TestTeamService_CreateTeam(t *testing.T) {
+	ctx := context.TODO()
+	gameID := uuid.New()
+	creator := uuid.New()
+	teammate := &entities.User{UserID: uuid.New(), Username: "carol"}
+	foosball := &entities.Game{GameID: gameID, GameName: "Foosball", MaxPlayers: 4}
+
+	t.Run("should register the creator and their teammate", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		teamID := uuid.New()
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(foosball, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "carol").Return(teammate, nil)
+		mockTeamRepo.EXPECT().FetchGameTeams(ctx, gameID).Return(nil, nil)
+		mockTeamRepo.EXPECT().CreateTeam(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, team *entities.Team) (uuid.UUID, error) {
+				assert.Equal(t, "Table Sharks", team.Name)
+				assert.Equal(t, []uuid.UUID{creator, teammate.UserID}, team.Members)
+				assert.Equal(t, 1500.0, team.Rating)
+				return teamID, nil
+			})
+
+		id, err := teamService.CreateTeam(ctx, creator, gameID, " Table Sharks ", []string{" carol\n"})
+		assert.NoError(t, err)
+		assert.Equal(t, teamID, id)
+	})
+
+	t.Run("should reject a name already used for the game", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(foosball, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "carol").Return(teammate, nil)
+		mockTeamRepo.EXPECT().FetchGameTeams(ctx, gameID).Return([]entities.Team{{Name: "table sharks", Members: []uuid.UUID{uuid.New(), uuid.New()}}}, nil)
+
+		_, err := teamService.CreateTeam(ctx, creator, gameID, "Table Sharks", []string{"carol"})
+		assert.ErrorIs(t, err, domain_errors.ErrTeamNameTaken)
+	})
+
+	t.Run("should reject players who already form a team", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(foosball, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, "carol").Return(teammate, nil)
+		mockTeamRepo.EXPECT().FetchGameTeams(ctx, gameID).Return([]entities.Team{{Name: "Spinners", Members: []uuid.UUID{teammate.UserID, creator}}}, nil)
+
+		_, err := teamService.CreateTeam(ctx, creator, gameID, "Table Sharks", []string{"carol"})
+		assert.ErrorIs(t, err, domain_errors.ErrTeamAlreadyExists)
+	})
+
+	t.Run("should reject a team too large to meet another team in a slot", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(foosball, nil)
+		mockUserService.EXPECT().GetUserByUsername(ctx, gomock.Any()).Return(teammate, nil)
+		mockUserService.EXPECT().GetUserByEmail(ctx, "dave@example.com").Return(&entities.User{UserID: uuid.New()}, nil)
+
+		_, err := teamService.CreateTeam(ctx, creator, gameID, "Table Sharks", []string{"carol", "dave@example.com"})
+		assert.EqualError(t, err, "a team of Foosball can have at most 2 players")
+	})
+
+	t.Run("should reject a team for a game that is not played in teams", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(&entities.Game{GameID: gameID, GameName: "Chess", MaxPlayers: 2}, nil)
+
+		_, err := teamService.CreateTeam(ctx, creator, gameID, "Table Sharks", []string{"carol"})
+		assert.EqualError(t, err, "Chess is not played in teams")
+	})
+
+	t.Run("should reject a team of one", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		mockGameService.EXPECT().GetGameByID(ctx, gameID).Return(foosball, nil)
+
+		_, err := teamService.CreateTeam(ctx, creator, gameID, "Table Sharks", nil)
+		assert.EqualError(t, err, "a team needs at least two players")
+	})
+
+	t.Run("should reject an empty name", func(t *testing.T) {
+		_, err := teamService.CreateTeam(ctx, creator, gameID, "  ", []string{"carol"})
+		assert.EqualError(t, err, "team name cannot be empty")
+	})
+}
+
+func TestTeamService_RateMatch(t *testing.T) {
+	ctx := context.TODO()
+	gameID := uuid.New()
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	doubles := []entities.MatchPlayer{
+		{UserID: alice, Side: 1, Outcome: entities.OutcomeWin},
+		{UserID: carol, Side: 1, Outcome: entities.OutcomeWin},
+		{UserID: bob, Side: 2, Outcome: entities.OutcomeLoss},
+		{UserID: dave, Side: 2, Outcome: entities.OutcomeLoss},
+	}
+	newTeam := func(name string, members ...uuid.UUID) entities.Team {
+		return entities.Team{TeamID: uuid.New(), GameID: gameID, Name: name, Members: members, Rating: 1500, RatingDeviation: 350, Volatility: 0.06}
+	}
+
+	t.Run("should credit and rate both teams", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		teams := []entities.Team{newTeam("Spinners", bob, dave), newTeam("Table Sharks", carol, alice)}
+		updated, err := teamService.RateMatch(ctx, teams, doubles)
+		assert.NoError(t, err)
+		assert.Len(t, updated, 2)
+		assert.Equal(t, "Table Sharks", updated[0].Name)
+		assert.Equal(t, 1, updated[0].Wins)
+		assert.Greater(t, updated[0].Rating, 1500.0)
+		assert.Equal(t, "Spinners", updated[1].Name)
+		assert.Equal(t, 1, updated[1].Losses)
+		assert.Less(t, updated[1].Rating, 1500.0)
+	})
+
+	t.Run("should count a match against players who are not a team without rating it", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		teams := []entities.Team{newTeam("Table Sharks", alice, carol), newTeam("Odd Pair", bob, uuid.New())}
+		updated, err := teamService.RateMatch(ctx, teams, doubles)
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.Team{&teams[0]}, updated)
+		assert.Equal(t, 1, teams[0].Wins)
+		assert.Equal(t, 1500.0, teams[0].Rating)
+		assert.Equal(t, 0, teams[1].Losses)
+	})
+
+	t.Run("should do nothing for a game without teams", func(t *testing.T) {
+		teardown := setup(t)
+		defer teardown()
+
+		updated, err := teamService.RateMatch(ctx, nil, doubles)
+		assert.NoError(t, err)
+		assert.Empty(t, updated)
+	})
+}
+
+func TestTeamService_DisbandTeam(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	ctx := context.TODO()
+	userID, teamID := uuid.New(), uuid.New()
+	mockTeamRepo.EXPECT().DeleteTeam(ctx, teamID, userID).Return(domain_errors.ErrNotATeamMember)
+
+	err := teamService.DisbandTeam(ctx, userID, teamID)
+	assert.ErrorIs(t, err, domain_errors.ErrNotATeamMember)
+}
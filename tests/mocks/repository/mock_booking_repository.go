@@ -9,6 +9,7 @@ import (
 	entities "project2/internal/domain/entities"
 	models "project2/internal/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,6 +38,65 @@ func (m *MockBookingRepository) EXPECT() *MockBookingRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddToWaitlist mocks base method.
+func (m *MockBookingRepository) AddToWaitlist(ctx context.Context, entry *entities.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWaitlist", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToWaitlist indicates an expected call of AddToWaitlist.
+func (mr *MockBookingRepositoryMockRecorder) AddToWaitlist(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWaitlist", reflect.TypeOf((*MockBookingRepository)(nil).AddToWaitlist), ctx, entry)
+}
+
+// CancelBookingAndPromote mocks base method.
+func (m *MockBookingRepository) CancelBookingAndPromote(ctx context.Context, bookingID uuid.UUID, maxPlayers int) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBookingAndPromote", ctx, bookingID, maxPlayers)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBookingAndPromote indicates an expected call of CancelBookingAndPromote.
+func (mr *MockBookingRepositoryMockRecorder) CancelBookingAndPromote(ctx, bookingID, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBookingAndPromote", reflect.TypeOf((*MockBookingRepository)(nil).CancelBookingAndPromote), ctx, bookingID, maxPlayers)
+}
+
+// CancelBookingsBelowMinPlayers mocks base method.
+func (m *MockBookingRepository) CancelBookingsBelowMinPlayers(ctx context.Context, slotID uuid.UUID, minPlayers int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBookingsBelowMinPlayers", ctx, slotID, minPlayers)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBookingsBelowMinPlayers indicates an expected call of CancelBookingsBelowMinPlayers.
+func (mr *MockBookingRepositoryMockRecorder) CancelBookingsBelowMinPlayers(ctx, slotID, minPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBookingsBelowMinPlayers", reflect.TypeOf((*MockBookingRepository)(nil).CancelBookingsBelowMinPlayers), ctx, slotID, minPlayers)
+}
+
+// CheckInBooking mocks base method.
+func (m *MockBookingRepository) CheckInBooking(ctx context.Context, userID uuid.UUID, code string, from, to time.Time) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInBooking", ctx, userID, code, from, to)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInBooking indicates an expected call of CheckInBooking.
+func (mr *MockBookingRepositoryMockRecorder) CheckInBooking(ctx, userID, code, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInBooking", reflect.TypeOf((*MockBookingRepository)(nil).CheckInBooking), ctx, userID, code, from, to)
+}
+
 // CreateBooking mocks base method.
 func (m *MockBookingRepository) CreateBooking(ctx context.Context, booking *entities.Booking) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +112,36 @@ func (mr *MockBookingRepositoryMockRecorder) CreateBooking(ctx, booking interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBooking", reflect.TypeOf((*MockBookingRepository)(nil).CreateBooking), ctx, booking)
 }
 
+// CreateBookingWithinCapacity mocks base method.
+func (m *MockBookingRepository) CreateBookingWithinCapacity(ctx context.Context, booking *entities.Booking, maxPlayers int) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBookingWithinCapacity", ctx, booking, maxPlayers)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBookingWithinCapacity indicates an expected call of CreateBookingWithinCapacity.
+func (mr *MockBookingRepositoryMockRecorder) CreateBookingWithinCapacity(ctx, booking, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingWithinCapacity", reflect.TypeOf((*MockBookingRepository)(nil).CreateBookingWithinCapacity), ctx, booking, maxPlayers)
+}
+
+// CreateGroupBooking mocks base method.
+func (m *MockBookingRepository) CreateGroupBooking(ctx context.Context, slotID uuid.UUID, userIDs []uuid.UUID, maxPlayers int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupBooking", ctx, slotID, userIDs, maxPlayers)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupBooking indicates an expected call of CreateGroupBooking.
+func (mr *MockBookingRepositoryMockRecorder) CreateGroupBooking(ctx, slotID, userIDs, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBooking", reflect.TypeOf((*MockBookingRepository)(nil).CreateGroupBooking), ctx, slotID, userIDs, maxPlayers)
+}
+
 // DeleteBookingByID mocks base method.
 func (m *MockBookingRepository) DeleteBookingByID(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -66,6 +156,21 @@ func (mr *MockBookingRepositoryMockRecorder) DeleteBookingByID(ctx, id interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookingByID", reflect.TypeOf((*MockBookingRepository)(nil).DeleteBookingByID), ctx, id)
 }
 
+// FetchAttendanceStats mocks base method.
+func (m *MockBookingRepository) FetchAttendanceStats(ctx context.Context, userID uuid.UUID) (models.AttendanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAttendanceStats", ctx, userID)
+	ret0, _ := ret[0].(models.AttendanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAttendanceStats indicates an expected call of FetchAttendanceStats.
+func (mr *MockBookingRepositoryMockRecorder) FetchAttendanceStats(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAttendanceStats", reflect.TypeOf((*MockBookingRepository)(nil).FetchAttendanceStats), ctx, userID)
+}
+
 // FetchBookingByID mocks base method.
 func (m *MockBookingRepository) FetchBookingByID(ctx context.Context, id uuid.UUID) (*entities.Booking, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +201,21 @@ func (mr *MockBookingRepositoryMockRecorder) FetchBookingBySlotAndUserId(ctx, sl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBookingBySlotAndUserId", reflect.TypeOf((*MockBookingRepository)(nil).FetchBookingBySlotAndUserId), ctx, slotId, userID)
 }
 
+// FetchBookingCountsBySlotIDs mocks base method.
+func (m *MockBookingRepository) FetchBookingCountsBySlotIDs(ctx context.Context, slotIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBookingCountsBySlotIDs", ctx, slotIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBookingCountsBySlotIDs indicates an expected call of FetchBookingCountsBySlotIDs.
+func (mr *MockBookingRepositoryMockRecorder) FetchBookingCountsBySlotIDs(ctx, slotIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBookingCountsBySlotIDs", reflect.TypeOf((*MockBookingRepository)(nil).FetchBookingCountsBySlotIDs), ctx, slotIDs)
+}
+
 // FetchBookingsBySlotID mocks base method.
 func (m *MockBookingRepository) FetchBookingsBySlotID(ctx context.Context, slotID uuid.UUID) ([]entities.Booking, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +291,81 @@ func (mr *MockBookingRepositoryMockRecorder) FetchUpcomingBookingsByUserID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUpcomingBookingsByUserID", reflect.TypeOf((*MockBookingRepository)(nil).FetchUpcomingBookingsByUserID), ctx, userID)
 }
 
+// FetchWaitlistPosition mocks base method.
+func (m *MockBookingRepository) FetchWaitlistPosition(ctx context.Context, slotID, userID uuid.UUID) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchWaitlistPosition", ctx, slotID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchWaitlistPosition indicates an expected call of FetchWaitlistPosition.
+func (mr *MockBookingRepositoryMockRecorder) FetchWaitlistPosition(ctx, slotID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWaitlistPosition", reflect.TypeOf((*MockBookingRepository)(nil).FetchWaitlistPosition), ctx, slotID, userID)
+}
+
+// MarkNoShows mocks base method.
+func (m *MockBookingRepository) MarkNoShows(ctx context.Context, startedAfter, startedBefore time.Time) ([]models.NoShow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNoShows", ctx, startedAfter, startedBefore)
+	ret0, _ := ret[0].([]models.NoShow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNoShows indicates an expected call of MarkNoShows.
+func (mr *MockBookingRepositoryMockRecorder) MarkNoShows(ctx, startedAfter, startedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNoShows", reflect.TypeOf((*MockBookingRepository)(nil).MarkNoShows), ctx, startedAfter, startedBefore)
+}
+
+// RemoveFromWaitlist mocks base method.
+func (m *MockBookingRepository) RemoveFromWaitlist(ctx context.Context, slotID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromWaitlist", ctx, slotID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromWaitlist indicates an expected call of RemoveFromWaitlist.
+func (mr *MockBookingRepositoryMockRecorder) RemoveFromWaitlist(ctx, slotID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromWaitlist", reflect.TypeOf((*MockBookingRepository)(nil).RemoveFromWaitlist), ctx, slotID, userID)
+}
+
+// RescheduleBooking mocks base method.
+func (m *MockBookingRepository) RescheduleBooking(ctx context.Context, bookingID, newSlotID uuid.UUID, maxPlayers int) (uuid.UUID, uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleBooking", ctx, bookingID, newSlotID, maxPlayers)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(uuid.UUID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RescheduleBooking indicates an expected call of RescheduleBooking.
+func (mr *MockBookingRepositoryMockRecorder) RescheduleBooking(ctx, bookingID, newSlotID, maxPlayers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleBooking", reflect.TypeOf((*MockBookingRepository)(nil).RescheduleBooking), ctx, bookingID, newSlotID, maxPlayers)
+}
+
+// TransferBooking mocks base method.
+func (m *MockBookingRepository) TransferBooking(ctx context.Context, bookingID, toUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferBooking", ctx, bookingID, toUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferBooking indicates an expected call of TransferBooking.
+func (mr *MockBookingRepositoryMockRecorder) TransferBooking(ctx, bookingID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferBooking", reflect.TypeOf((*MockBookingRepository)(nil).TransferBooking), ctx, bookingID, toUserID)
+}
+
 // UpdateBookingResult mocks base method.
 func (m *MockBookingRepository) UpdateBookingResult(ctx context.Context, bookingId uuid.UUID, result string) error {
 	m.ctrl.T.Helper()
@@ -183,4 +378,4 @@ func (m *MockBookingRepository) UpdateBookingResult(ctx context.Context, booking
 func (mr *MockBookingRepositoryMockRecorder) UpdateBookingResult(ctx, bookingId, result interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingResult", reflect.TypeOf((*MockBookingRepository)(nil).UpdateBookingResult), ctx, bookingId, result)
-}
\ No newline at end of file
+}
@@ -95,6 +95,20 @@ func (mr *MockGameRepositoryMockRecorder) FetchGameByID(ctx, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchGameByID", reflect.TypeOf((*MockGameRepository)(nil).FetchGameByID), ctx, id)
 }
 
+// UpdateGameSchedule mocks base method.
+func (m *MockGameRepository) UpdateGameSchedule(ctx context.Context, game *entities.Game) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameSchedule", ctx, game)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameSchedule indicates an expected call of UpdateGameSchedule.
+func (mr *MockGameRepositoryMockRecorder) UpdateGameSchedule(ctx, game interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSchedule", reflect.TypeOf((*MockGameRepository)(nil).UpdateGameSchedule), ctx, game)
+}
+
 // UpdateGameStatus mocks base method.
 func (m *MockGameRepository) UpdateGameStatus(ctx context.Context, gameID uuid.UUID, status bool) error {
 	m.ctrl.T.Helper()
@@ -107,4 +121,18 @@ func (m *MockGameRepository) UpdateGameStatus(ctx context.Context, gameID uuid.U
 func (mr *MockGameRepositoryMockRecorder) UpdateGameStatus(ctx, gameID, status interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameStatus", reflect.TypeOf((*MockGameRepository)(nil).UpdateGameStatus), ctx, gameID, status)
-}
\ No newline at end of file
+}
+
+// UpdateScoringStrategy mocks base method.
+func (m *MockGameRepository) UpdateScoringStrategy(ctx context.Context, gameID uuid.UUID, strategy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScoringStrategy", ctx, gameID, strategy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScoringStrategy indicates an expected call of UpdateScoringStrategy.
+func (mr *MockGameRepositoryMockRecorder) UpdateScoringStrategy(ctx, gameID, strategy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScoringStrategy", reflect.TypeOf((*MockGameRepository)(nil).UpdateScoringStrategy), ctx, gameID, strategy)
+}
@@ -38,18 +38,48 @@ func (m *MockLeaderboardRepository) EXPECT() *MockLeaderboardRepositoryMockRecor
 }
 
 // FetchGameLeaderboard mocks base method.
-func (m *MockLeaderboardRepository) FetchGameLeaderboard(ctx context.Context, gameID uuid.UUID) ([]models.Leaderboard, error) {
+func (m *MockLeaderboardRepository) FetchGameLeaderboard(ctx context.Context, gameID uuid.UUID, provisionalGames int) ([]models.Leaderboard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchGameLeaderboard", ctx, gameID)
+	ret := m.ctrl.Call(m, "FetchGameLeaderboard", ctx, gameID, provisionalGames)
 	ret0, _ := ret[0].([]models.Leaderboard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchGameLeaderboard indicates an expected call of FetchGameLeaderboard.
-func (mr *MockLeaderboardRepositoryMockRecorder) FetchGameLeaderboard(ctx, gameID interface{}) *gomock.Call {
+func (mr *MockLeaderboardRepositoryMockRecorder) FetchGameLeaderboard(ctx, gameID, provisionalGames interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchGameLeaderboard", reflect.TypeOf((*MockLeaderboardRepository)(nil).FetchGameLeaderboard), ctx, gameID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchGameLeaderboard", reflect.TypeOf((*MockLeaderboardRepository)(nil).FetchGameLeaderboard), ctx, gameID, provisionalGames)
+}
+
+// FetchGameStats mocks base method.
+func (m *MockLeaderboardRepository) FetchGameStats(ctx context.Context, gameID uuid.UUID) ([]*entities.Leaderboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchGameStats", ctx, gameID)
+	ret0, _ := ret[0].([]*entities.Leaderboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchGameStats indicates an expected call of FetchGameStats.
+func (mr *MockLeaderboardRepositoryMockRecorder) FetchGameStats(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchGameStats", reflect.TypeOf((*MockLeaderboardRepository)(nil).FetchGameStats), ctx, gameID)
+}
+
+// FetchMatchHistory mocks base method.
+func (m *MockLeaderboardRepository) FetchMatchHistory(ctx context.Context, gameID uuid.UUID) ([][]entities.MatchPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchMatchHistory", ctx, gameID)
+	ret0, _ := ret[0].([][]entities.MatchPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMatchHistory indicates an expected call of FetchMatchHistory.
+func (mr *MockLeaderboardRepositoryMockRecorder) FetchMatchHistory(ctx, gameID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMatchHistory", reflect.TypeOf((*MockLeaderboardRepository)(nil).FetchMatchHistory), ctx, gameID)
 }
 
 // FetchUserGameStats mocks base method.
@@ -82,6 +112,20 @@ func (mr *MockLeaderboardRepositoryMockRecorder) FetchUserOverallStats(ctx, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserOverallStats", reflect.TypeOf((*MockLeaderboardRepository)(nil).FetchUserOverallStats), ctx, userID)
 }
 
+// UpdateScores mocks base method.
+func (m *MockLeaderboardRepository) UpdateScores(ctx context.Context, leaderboard []*entities.Leaderboard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScores", ctx, leaderboard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScores indicates an expected call of UpdateScores.
+func (mr *MockLeaderboardRepositoryMockRecorder) UpdateScores(ctx, leaderboard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScores", reflect.TypeOf((*MockLeaderboardRepository)(nil).UpdateScores), ctx, leaderboard)
+}
+
 // UpdateUserGameStats mocks base method.
 func (m *MockLeaderboardRepository) UpdateUserGameStats(ctx context.Context, leaderboard *entities.Leaderboard) error {
 	m.ctrl.T.Helper()
@@ -94,4 +138,4 @@ func (m *MockLeaderboardRepository) UpdateUserGameStats(ctx context.Context, lea
 func (mr *MockLeaderboardRepositoryMockRecorder) UpdateUserGameStats(ctx, leaderboard interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserGameStats", reflect.TypeOf((*MockLeaderboardRepository)(nil).UpdateUserGameStats), ctx, leaderboard)
-}
\ No newline at end of file
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	entities "project2/internal/domain/entities"
+	models "project2/internal/models"
 	reflect "reflect"
 	time "time"
 
@@ -66,6 +67,21 @@ func (mr *MockSlotRepositoryMockRecorder) DeleteSlotByID(ctx, id interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSlotByID", reflect.TypeOf((*MockSlotRepository)(nil).DeleteSlotByID), ctx, id)
 }
 
+// FetchFreeSlots mocks base method.
+func (m *MockSlotRepository) FetchFreeSlots(ctx context.Context, search models.SlotSearch) ([]models.FreeSlot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchFreeSlots", ctx, search)
+	ret0, _ := ret[0].([]models.FreeSlot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchFreeSlots indicates an expected call of FetchFreeSlots.
+func (mr *MockSlotRepositoryMockRecorder) FetchFreeSlots(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchFreeSlots", reflect.TypeOf((*MockSlotRepository)(nil).FetchFreeSlots), ctx, search)
+}
+
 // FetchSlotByDateAndTime mocks base method.
 func (m *MockSlotRepository) FetchSlotByDateAndTime(ctx context.Context, date, startTime time.Time) (*entities.Slot, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +112,36 @@ func (mr *MockSlotRepositoryMockRecorder) FetchSlotByID(ctx, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSlotByID", reflect.TypeOf((*MockSlotRepository)(nil).FetchSlotByID), ctx, id)
 }
 
+// FetchSlotOccupancyByDate mocks base method.
+func (m *MockSlotRepository) FetchSlotOccupancyByDate(ctx context.Context, date time.Time) ([]models.SlotOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSlotOccupancyByDate", ctx, date)
+	ret0, _ := ret[0].([]models.SlotOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSlotOccupancyByDate indicates an expected call of FetchSlotOccupancyByDate.
+func (mr *MockSlotRepositoryMockRecorder) FetchSlotOccupancyByDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSlotOccupancyByDate", reflect.TypeOf((*MockSlotRepository)(nil).FetchSlotOccupancyByDate), ctx, date)
+}
+
+// FetchSlotOccupancyByGameIDAndDate mocks base method.
+func (m *MockSlotRepository) FetchSlotOccupancyByGameIDAndDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSlotOccupancyByGameIDAndDate", ctx, gameID, date)
+	ret0, _ := ret[0].([]models.SlotOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSlotOccupancyByGameIDAndDate indicates an expected call of FetchSlotOccupancyByGameIDAndDate.
+func (mr *MockSlotRepositoryMockRecorder) FetchSlotOccupancyByGameIDAndDate(ctx, gameID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSlotOccupancyByGameIDAndDate", reflect.TypeOf((*MockSlotRepository)(nil).FetchSlotOccupancyByGameIDAndDate), ctx, gameID, date)
+}
+
 // FetchSlotsByDate mocks base method.
 func (m *MockSlotRepository) FetchSlotsByDate(ctx context.Context, date time.Time) ([]entities.Slot, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +187,21 @@ func (mr *MockSlotRepositoryMockRecorder) FetchSlotsByGameIDAndDate(ctx, gameID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSlotsByGameIDAndDate", reflect.TypeOf((*MockSlotRepository)(nil).FetchSlotsByGameIDAndDate), ctx, gameID, date)
 }
 
+// FetchSlotsStartingBetween mocks base method.
+func (m *MockSlotRepository) FetchSlotsStartingBetween(ctx context.Context, from, to time.Time) ([]entities.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSlotsStartingBetween", ctx, from, to)
+	ret0, _ := ret[0].([]entities.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSlotsStartingBetween indicates an expected call of FetchSlotsStartingBetween.
+func (mr *MockSlotRepositoryMockRecorder) FetchSlotsStartingBetween(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSlotsStartingBetween", reflect.TypeOf((*MockSlotRepository)(nil).FetchSlotsStartingBetween), ctx, from, to)
+}
+
 // UpdateSlotStatus mocks base method.
 func (m *MockSlotRepository) UpdateSlotStatus(ctx context.Context, slotID uuid.UUID, isBooked bool) error {
 	m.ctrl.T.Helper()
@@ -153,4 +214,4 @@ func (m *MockSlotRepository) UpdateSlotStatus(ctx context.Context, slotID uuid.U
 func (mr *MockSlotRepositoryMockRecorder) UpdateSlotStatus(ctx, slotID, isBooked interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSlotStatus", reflect.TypeOf((*MockSlotRepository)(nil).UpdateSlotStatus), ctx, slotID, isBooked)
-}
\ No newline at end of file
+}
@@ -36,6 +36,49 @@ func (m *MockBookingService) EXPECT() *MockBookingServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelBooking mocks base method.
+func (m *MockBookingService) CancelBooking(ctx context.Context, userID, bookingID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBooking", ctx, userID, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelBooking indicates an expected call of CancelBooking.
+func (mr *MockBookingServiceMockRecorder) CancelBooking(ctx, userID, bookingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingService)(nil).CancelBooking), ctx, userID, bookingID)
+}
+
+// CheckIn mocks base method.
+func (m *MockBookingService) CheckIn(ctx context.Context, userID uuid.UUID, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockBookingServiceMockRecorder) CheckIn(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockBookingService)(nil).CheckIn), ctx, userID, code)
+}
+
+// GetAttendanceStats mocks base method.
+func (m *MockBookingService) GetAttendanceStats(ctx context.Context, userID uuid.UUID) (models.AttendanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceStats", ctx, userID)
+	ret0, _ := ret[0].(models.AttendanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceStats indicates an expected call of GetAttendanceStats.
+func (mr *MockBookingServiceMockRecorder) GetAttendanceStats(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceStats", reflect.TypeOf((*MockBookingService)(nil).GetAttendanceStats), ctx, userID)
+}
+
 // GetBookingByUserAndSlotID mocks base method.
 func (m *MockBookingService) GetBookingByUserAndSlotID(ctx context.Context, userID, slotID uuid.UUID) (models.Bookings, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +124,21 @@ func (mr *MockBookingServiceMockRecorder) GetSlotBookedUsers(ctx, slotId interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotBookedUsers", reflect.TypeOf((*MockBookingService)(nil).GetSlotBookedUsers), ctx, slotId)
 }
 
+// GetSlotBookingCounts mocks base method.
+func (m *MockBookingService) GetSlotBookingCounts(ctx context.Context, slotIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlotBookingCounts", ctx, slotIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlotBookingCounts indicates an expected call of GetSlotBookingCounts.
+func (mr *MockBookingServiceMockRecorder) GetSlotBookingCounts(ctx, slotIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotBookingCounts", reflect.TypeOf((*MockBookingService)(nil).GetSlotBookingCounts), ctx, slotIDs)
+}
+
 // GetUpcomingBookings mocks base method.
 func (m *MockBookingService) GetUpcomingBookings(ctx context.Context, userID uuid.UUID) ([]models.Bookings, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +154,51 @@ func (mr *MockBookingServiceMockRecorder) GetUpcomingBookings(ctx, userID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingBookings", reflect.TypeOf((*MockBookingService)(nil).GetUpcomingBookings), ctx, userID)
 }
 
+// GetWaitlistPosition mocks base method.
+func (m *MockBookingService) GetWaitlistPosition(ctx context.Context, userID, slotID uuid.UUID) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistPosition", ctx, userID, slotID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWaitlistPosition indicates an expected call of GetWaitlistPosition.
+func (mr *MockBookingServiceMockRecorder) GetWaitlistPosition(ctx, userID, slotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistPosition", reflect.TypeOf((*MockBookingService)(nil).GetWaitlistPosition), ctx, userID, slotID)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockBookingService) JoinWaitlist(ctx context.Context, userID, slotID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx, userID, slotID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockBookingServiceMockRecorder) JoinWaitlist(ctx, userID, slotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockBookingService)(nil).JoinWaitlist), ctx, userID, slotID)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockBookingService) LeaveWaitlist(ctx context.Context, userID, slotID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx, userID, slotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockBookingServiceMockRecorder) LeaveWaitlist(ctx, userID, slotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockBookingService)(nil).LeaveWaitlist), ctx, userID, slotID)
+}
+
 // MakeBooking mocks base method.
 func (m *MockBookingService) MakeBooking(ctx context.Context, userID, slotID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -110,6 +213,48 @@ func (mr *MockBookingServiceMockRecorder) MakeBooking(ctx, userID, slotID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeBooking", reflect.TypeOf((*MockBookingService)(nil).MakeBooking), ctx, userID, slotID)
 }
 
+// MakeGroupBooking mocks base method.
+func (m *MockBookingService) MakeGroupBooking(ctx context.Context, organiserID, slotID uuid.UUID, members []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeGroupBooking", ctx, organiserID, slotID, members)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MakeGroupBooking indicates an expected call of MakeGroupBooking.
+func (mr *MockBookingServiceMockRecorder) MakeGroupBooking(ctx, organiserID, slotID, members interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeGroupBooking", reflect.TypeOf((*MockBookingService)(nil).MakeGroupBooking), ctx, organiserID, slotID, members)
+}
+
+// RescheduleBooking mocks base method.
+func (m *MockBookingService) RescheduleBooking(ctx context.Context, userID, bookingID, newSlotID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleBooking", ctx, userID, bookingID, newSlotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleBooking indicates an expected call of RescheduleBooking.
+func (mr *MockBookingServiceMockRecorder) RescheduleBooking(ctx, userID, bookingID, newSlotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleBooking", reflect.TypeOf((*MockBookingService)(nil).RescheduleBooking), ctx, userID, bookingID, newSlotID)
+}
+
+// TransferBooking mocks base method.
+func (m *MockBookingService) TransferBooking(ctx context.Context, userID, bookingID uuid.UUID, recipient string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferBooking", ctx, userID, bookingID, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferBooking indicates an expected call of TransferBooking.
+func (mr *MockBookingServiceMockRecorder) TransferBooking(ctx, userID, bookingID, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferBooking", reflect.TypeOf((*MockBookingService)(nil).TransferBooking), ctx, userID, bookingID, recipient)
+}
+
 // UpdateBookingResult mocks base method.
 func (m *MockBookingService) UpdateBookingResult(ctx context.Context, bookingId uuid.UUID, result string) error {
 	m.ctrl.T.Helper()
@@ -122,4 +267,4 @@ func (m *MockBookingService) UpdateBookingResult(ctx context.Context, bookingId
 func (mr *MockBookingServiceMockRecorder) UpdateBookingResult(ctx, bookingId, result interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingResult", reflect.TypeOf((*MockBookingService)(nil).UpdateBookingResult), ctx, bookingId, result)
-}
\ No newline at end of file
+}
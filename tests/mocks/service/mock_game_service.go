@@ -95,6 +95,20 @@ func (mr *MockGameServiceMockRecorder) GetGameByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameByID", reflect.TypeOf((*MockGameService)(nil).GetGameByID), ctx, id)
 }
 
+// UpdateGameSchedule mocks base method.
+func (m *MockGameService) UpdateGameSchedule(ctx context.Context, game *entities.Game) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameSchedule", ctx, game)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameSchedule indicates an expected call of UpdateGameSchedule.
+func (mr *MockGameServiceMockRecorder) UpdateGameSchedule(ctx, game interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSchedule", reflect.TypeOf((*MockGameService)(nil).UpdateGameSchedule), ctx, game)
+}
+
 // UpdateGameStatus mocks base method.
 func (m *MockGameService) UpdateGameStatus(ctx context.Context, id uuid.UUID, isActive bool) error {
 	m.ctrl.T.Helper()
@@ -107,4 +121,18 @@ func (m *MockGameService) UpdateGameStatus(ctx context.Context, id uuid.UUID, is
 func (mr *MockGameServiceMockRecorder) UpdateGameStatus(ctx, id, isActive interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameStatus", reflect.TypeOf((*MockGameService)(nil).UpdateGameStatus), ctx, id, isActive)
-}
\ No newline at end of file
+}
+
+// UpdateScoringStrategy mocks base method.
+func (m *MockGameService) UpdateScoringStrategy(ctx context.Context, id uuid.UUID, strategy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScoringStrategy", ctx, id, strategy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScoringStrategy indicates an expected call of UpdateScoringStrategy.
+func (mr *MockGameServiceMockRecorder) UpdateScoringStrategy(ctx, id, strategy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScoringStrategy", reflect.TypeOf((*MockGameService)(nil).UpdateScoringStrategy), ctx, id, strategy)
+}
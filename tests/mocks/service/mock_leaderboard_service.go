@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	entities "project2/internal/domain/entities"
 	models "project2/internal/models"
 	reflect "reflect"
 
@@ -36,32 +37,18 @@ func (m *MockLeaderboardService) EXPECT() *MockLeaderboardServiceMockRecorder {
 	return m.recorder
 }
 
-// AddLossToUser mocks base method.
-func (m *MockLeaderboardService) AddLossToUser(ctx context.Context, userId, gameId, bookingId uuid.UUID) error {
+// ChangeScoringStrategy mocks base method.
+func (m *MockLeaderboardService) ChangeScoringStrategy(ctx context.Context, gameId uuid.UUID, strategy string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddLossToUser", ctx, userId, gameId, bookingId)
+	ret := m.ctrl.Call(m, "ChangeScoringStrategy", ctx, gameId, strategy)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddLossToUser indicates an expected call of AddLossToUser.
-func (mr *MockLeaderboardServiceMockRecorder) AddLossToUser(ctx, userId, gameId, bookingId interface{}) *gomock.Call {
+// ChangeScoringStrategy indicates an expected call of ChangeScoringStrategy.
+func (mr *MockLeaderboardServiceMockRecorder) ChangeScoringStrategy(ctx, gameId, strategy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLossToUser", reflect.TypeOf((*MockLeaderboardService)(nil).AddLossToUser), ctx, userId, gameId, bookingId)
-}
-
-// AddWinToUser mocks base method.
-func (m *MockLeaderboardService) AddWinToUser(ctx context.Context, userId, gameId, bookingId uuid.UUID) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddWinToUser", ctx, userId, gameId, bookingId)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// AddWinToUser indicates an expected call of AddWinToUser.
-func (mr *MockLeaderboardServiceMockRecorder) AddWinToUser(ctx, userId, gameId, bookingId interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWinToUser", reflect.TypeOf((*MockLeaderboardService)(nil).AddWinToUser), ctx, userId, gameId, bookingId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeScoringStrategy", reflect.TypeOf((*MockLeaderboardService)(nil).ChangeScoringStrategy), ctx, gameId, strategy)
 }
 
 // GetGameLeaderboard mocks base method.
@@ -78,3 +65,18 @@ func (mr *MockLeaderboardServiceMockRecorder) GetGameLeaderboard(ctx, gameId int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameLeaderboard", reflect.TypeOf((*MockLeaderboardService)(nil).GetGameLeaderboard), ctx, gameId)
 }
+
+// ScoreMatch mocks base method.
+func (m *MockLeaderboardService) ScoreMatch(ctx context.Context, gameId uuid.UUID, standings []*entities.Leaderboard, players []entities.MatchPlayer) ([]*entities.Leaderboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScoreMatch", ctx, gameId, standings, players)
+	ret0, _ := ret[0].([]*entities.Leaderboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScoreMatch indicates an expected call of ScoreMatch.
+func (mr *MockLeaderboardServiceMockRecorder) ScoreMatch(ctx, gameId, standings, players interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScoreMatch", reflect.TypeOf((*MockLeaderboardService)(nil).ScoreMatch), ctx, gameId, standings, players)
+}
@@ -50,3 +50,17 @@ func (mr *MockNotificationServiceMockRecorder) GetUserNotifications(ctx, userId
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotifications", reflect.TypeOf((*MockNotificationService)(nil).GetUserNotifications), ctx, userId)
 }
+
+// NotifyUser mocks base method.
+func (m *MockNotificationService) NotifyUser(ctx context.Context, userId uuid.UUID, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyUser", ctx, userId, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyUser indicates an expected call of NotifyUser.
+func (mr *MockNotificationServiceMockRecorder) NotifyUser(ctx, userId, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyUser", reflect.TypeOf((*MockNotificationService)(nil).NotifyUser), ctx, userId, message)
+}
@@ -7,7 +7,9 @@ package mocks
 import (
 	context "context"
 	entities "project2/internal/domain/entities"
+	models "project2/internal/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +38,21 @@ func (m *MockSlotService) EXPECT() *MockSlotServiceMockRecorder {
 	return m.recorder
 }
 
+// FindFreeSlots mocks base method.
+func (m *MockSlotService) FindFreeSlots(ctx context.Context, search models.SlotSearch) ([]models.FreeSlot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFreeSlots", ctx, search)
+	ret0, _ := ret[0].([]models.FreeSlot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFreeSlots indicates an expected call of FindFreeSlots.
+func (mr *MockSlotServiceMockRecorder) FindFreeSlots(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFreeSlots", reflect.TypeOf((*MockSlotService)(nil).FindFreeSlots), ctx, search)
+}
+
 // GetCurrentDayGameSlots mocks base method.
 func (m *MockSlotService) GetCurrentDayGameSlots(ctx context.Context, gameID uuid.UUID) ([]entities.Slot, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +68,51 @@ func (mr *MockSlotServiceMockRecorder) GetCurrentDayGameSlots(ctx, gameID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentDayGameSlots", reflect.TypeOf((*MockSlotService)(nil).GetCurrentDayGameSlots), ctx, gameID)
 }
 
+// GetGameSlotOccupancy mocks base method.
+func (m *MockSlotService) GetGameSlotOccupancy(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameSlotOccupancy", ctx, gameID, date)
+	ret0, _ := ret[0].([]models.SlotOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameSlotOccupancy indicates an expected call of GetGameSlotOccupancy.
+func (mr *MockSlotServiceMockRecorder) GetGameSlotOccupancy(ctx, gameID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameSlotOccupancy", reflect.TypeOf((*MockSlotService)(nil).GetGameSlotOccupancy), ctx, gameID, date)
+}
+
+// GetGameSlotsByDate mocks base method.
+func (m *MockSlotService) GetGameSlotsByDate(ctx context.Context, gameID uuid.UUID, date time.Time) ([]entities.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameSlotsByDate", ctx, gameID, date)
+	ret0, _ := ret[0].([]entities.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameSlotsByDate indicates an expected call of GetGameSlotsByDate.
+func (mr *MockSlotServiceMockRecorder) GetGameSlotsByDate(ctx, gameID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameSlotsByDate", reflect.TypeOf((*MockSlotService)(nil).GetGameSlotsByDate), ctx, gameID, date)
+}
+
+// GetJoinableGameSlots mocks base method.
+func (m *MockSlotService) GetJoinableGameSlots(ctx context.Context, gameID uuid.UUID, date time.Time) ([]models.SlotOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinableGameSlots", ctx, gameID, date)
+	ret0, _ := ret[0].([]models.SlotOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinableGameSlots indicates an expected call of GetJoinableGameSlots.
+func (mr *MockSlotServiceMockRecorder) GetJoinableGameSlots(ctx, gameID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinableGameSlots", reflect.TypeOf((*MockSlotService)(nil).GetJoinableGameSlots), ctx, gameID, date)
+}
+
 // GetSlotByID mocks base method.
 func (m *MockSlotService) GetSlotByID(ctx context.Context, slotID uuid.UUID) (*entities.Slot, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +128,35 @@ func (mr *MockSlotServiceMockRecorder) GetSlotByID(ctx, slotID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotByID", reflect.TypeOf((*MockSlotService)(nil).GetSlotByID), ctx, slotID)
 }
 
+// GetSlotOccupancyByDate mocks base method.
+func (m *MockSlotService) GetSlotOccupancyByDate(ctx context.Context, date time.Time) ([]models.SlotOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlotOccupancyByDate", ctx, date)
+	ret0, _ := ret[0].([]models.SlotOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlotOccupancyByDate indicates an expected call of GetSlotOccupancyByDate.
+func (mr *MockSlotServiceMockRecorder) GetSlotOccupancyByDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlotOccupancyByDate", reflect.TypeOf((*MockSlotService)(nil).GetSlotOccupancyByDate), ctx, date)
+}
+
+// MarkSlotAsAvailable mocks base method.
+func (m *MockSlotService) MarkSlotAsAvailable(ctx context.Context, slotID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSlotAsAvailable", ctx, slotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSlotAsAvailable indicates an expected call of MarkSlotAsAvailable.
+func (mr *MockSlotServiceMockRecorder) MarkSlotAsAvailable(ctx, slotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSlotAsAvailable", reflect.TypeOf((*MockSlotService)(nil).MarkSlotAsAvailable), ctx, slotID)
+}
+
 // MarkSlotAsBooked mocks base method.
 func (m *MockSlotService) MarkSlotAsBooked(ctx context.Context, slotID uuid.UUID) error {
 	m.ctrl.T.Helper()
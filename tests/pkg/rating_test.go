@@ -0,0 +1,60 @@
+package pkg_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project2/pkg/rating"
+	"testing"
+)
+
+func TestGlicko2_Update(t *testing.T) {
+	// Worked example from Glickman's "Example of the Glicko-2 system"
+	player := rating.Rating{Value: 1500, Deviation: 200, Volatility: 0.06}
+	results := []rating.Result{
+		{Opponent: rating.Rating{Value: 1400, Deviation: 30, Volatility: 0.06}, Score: 1},
+		{Opponent: rating.Rating{Value: 1550, Deviation: 100, Volatility: 0.06}, Score: 0},
+		{Opponent: rating.Rating{Value: 1700, Deviation: 300, Volatility: 0.06}, Score: 0},
+	}
+
+	updated := rating.Glicko2{Tau: 0.5}.Update(player, results)
+	assert.InDelta(t, 1464.06, updated.Value, 0.01)
+	assert.InDelta(t, 151.52, updated.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, updated.Volatility, 0.00001)
+}
+
+func TestGlicko2_UpdateWithoutGames(t *testing.T) {
+	player := rating.Rating{Value: 1500, Deviation: 50, Volatility: 0.06}
+
+	updated := rating.Glicko2{Tau: 0.5}.Update(player, nil)
+	assert.Equal(t, player.Value, updated.Value)
+	assert.Greater(t, updated.Deviation, player.Deviation)
+}
+
+func TestElo_Update(t *testing.T) {
+	elo := rating.Elo{KFactor: 32}
+	player := rating.Rating{Value: 1500}
+
+	t.Run("a win against an equal opponent gains half the K-factor", func(t *testing.T) {
+		updated := elo.Update(player, []rating.Result{{Opponent: rating.Rating{Value: 1500}, Score: 1}})
+		assert.InDelta(t, 1516, updated.Value, 0.001)
+	})
+
+	t.Run("a win against a much weaker opponent gains almost nothing", func(t *testing.T) {
+		updated := elo.Update(player, []rating.Result{{Opponent: rating.Rating{Value: 900}, Score: 1}})
+		assert.Less(t, updated.Value-player.Value, 1.0)
+	})
+
+	t.Run("a draw against an equal opponent changes nothing", func(t *testing.T) {
+		updated := elo.Update(player, []rating.Result{{Opponent: rating.Rating{Value: 1500}, Score: 0.5}})
+		assert.Equal(t, player.Value, updated.Value)
+	})
+}
+
+func TestNewRatingSystem(t *testing.T) {
+	system, err := rating.New(rating.SystemElo, 32, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, rating.Elo{KFactor: 32}, system)
+
+	_, err = rating.New("chess.com", 32, 0.5)
+	assert.Error(t, err)
+}
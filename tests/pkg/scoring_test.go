@@ -0,0 +1,115 @@
+package pkg_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"project2/internal/domain/entities"
+	"project2/pkg/scoring"
+	"project2/pkg/utils"
+	"testing"
+)
+
+var points = scoring.Points{Win: 3, Draw: 1, Loss: 0}
+
+func standing(wins, draws, losses int, score float64) *entities.Leaderboard {
+	return &entities.Leaderboard{UserID: uuid.New(), Wins: wins, Draws: draws, Losses: losses, Score: score, Rating: 1500}
+}
+
+func TestRecordStrategies_Update(t *testing.T) {
+	player := standing(4, 1, 2, 0)
+	player.Rating = 1587.5
+	bystander := standing(1, 0, 0, 12)
+	match := []entities.MatchPlayer{{UserID: player.UserID, Side: 1, Outcome: entities.OutcomeWin}}
+
+	tests := []struct {
+		strategy string
+		expected float64
+	}{
+		{strategy: scoring.StrategyWinRatio, expected: float64(utils.GetTotalScoreWithDraws(4, 1, 2))},
+		{strategy: scoring.StrategyRating, expected: 1587.5},
+		{strategy: scoring.StrategyPoints, expected: 13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			strategy, err := scoring.New(tt.strategy, points)
+			require.NoError(t, err)
+
+			strategy.Update([]*entities.Leaderboard{player, bystander}, match)
+			assert.Equal(t, tt.expected, player.Score)
+			assert.Equal(t, float64(12), bystander.Score, "players outside the match keep their score")
+		})
+	}
+}
+
+func TestLadder_Update(t *testing.T) {
+	first, second, third := standing(0, 0, 0, 3), standing(0, 0, 0, 2), standing(0, 0, 0, 1)
+
+	t.Run("a winner below the loser takes their place", func(t *testing.T) {
+		scoring.Ladder{}.Update([]*entities.Leaderboard{first, second, third}, []entities.MatchPlayer{
+			{UserID: third.UserID, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: first.UserID, Side: 2, Outcome: entities.OutcomeLoss},
+		})
+		assert.Equal(t, []float64{2, 1, 3}, []float64{first.Score, second.Score, third.Score})
+	})
+
+	t.Run("a winner above the loser stays put", func(t *testing.T) {
+		scoring.Ladder{}.Update([]*entities.Leaderboard{first, second, third}, []entities.MatchPlayer{
+			{UserID: third.UserID, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: second.UserID, Side: 2, Outcome: entities.OutcomeLoss},
+		})
+		assert.Equal(t, []float64{2, 1, 3}, []float64{first.Score, second.Score, third.Score})
+	})
+
+	t.Run("a draw leaves the ladder unchanged", func(t *testing.T) {
+		scoring.Ladder{}.Update([]*entities.Leaderboard{first, second, third}, []entities.MatchPlayer{
+			{UserID: second.UserID, Side: 1, Outcome: entities.OutcomeDraw},
+			{UserID: third.UserID, Side: 2, Outcome: entities.OutcomeDraw},
+		})
+		assert.Equal(t, []float64{2, 1, 3}, []float64{first.Score, second.Score, third.Score})
+	})
+
+	t.Run("newcomers join at the bottom", func(t *testing.T) {
+		newcomer := standing(0, 0, 1, 0)
+		scoring.Ladder{}.Update([]*entities.Leaderboard{first, second, third, newcomer}, []entities.MatchPlayer{
+			{UserID: third.UserID, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: newcomer.UserID, Side: 2, Outcome: entities.OutcomeLoss},
+		})
+		assert.Equal(t, []float64{3, 2, 4, 1}, []float64{first.Score, second.Score, third.Score, newcomer.Score})
+	})
+}
+
+func TestLadder_Recompute(t *testing.T) {
+	alice, bob, carol := standing(1, 0, 1, 9), standing(1, 0, 0, 9), standing(5, 0, 0, 9)
+	history := [][]entities.MatchPlayer{
+		{
+			{UserID: alice.UserID, Side: 1, Outcome: entities.OutcomeWin},
+			{UserID: bob.UserID, Side: 2, Outcome: entities.OutcomeLoss},
+		},
+		{
+			{UserID: alice.UserID, Side: 1, Outcome: entities.OutcomeLoss},
+			{UserID: bob.UserID, Side: 2, Outcome: entities.OutcomeWin},
+		},
+	}
+
+	scoring.Ladder{}.Recompute([]*entities.Leaderboard{carol, alice, bob}, history)
+
+	// carol has no recorded matches, so they go below everyone who has
+	assert.Equal(t, float64(3), bob.Score)
+	assert.Equal(t, float64(2), alice.Score)
+	assert.Equal(t, float64(1), carol.Score)
+}
+
+func TestNewScoringStrategy(t *testing.T) {
+	for _, name := range scoring.Strategies {
+		strategy, err := scoring.New(name, points)
+		assert.NoError(t, err)
+		assert.NotNil(t, strategy)
+		assert.True(t, scoring.IsValid(name))
+	}
+
+	_, err := scoring.New("golf", points)
+	assert.EqualError(t, err, `unknown scoring strategy "golf"`)
+	assert.False(t, scoring.IsValid("golf"))
+}
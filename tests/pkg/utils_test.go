@@ -6,10 +6,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"project2/internal/config"
 	"project2/internal/domain/entities"
+	"project2/internal/models"
 	"project2/pkg/utils"
 	"project2/pkg/validation"
 	mocks "project2/tests/mocks/repository"
+	"strings"
 	"testing"
 	"time"
 )
@@ -90,16 +93,21 @@ func TestGetTotalScore(t *testing.T) {
 }
 
 func TestInsertAllSlots(t *testing.T) {
+	openEveryDay(t)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	// Create mock repositories
 	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
 	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+	mockHolidayRepo.EXPECT().FetchHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 
 	// Setup test data
 	gameID := uuid.New()
-	today := time.Now().Truncate(24 * time.Hour)
+	today := utils.StartOfDay(utils.Now())
 	games := []entities.Game{
 		{GameID: gameID, GameName: "Table Tennis"},
 	}
@@ -110,11 +118,13 @@ func TestInsertAllSlots(t *testing.T) {
 		Return(games, nil).
 		Times(1)
 
-	// Mock FetchSlotsByGameIDAndDate to return empty slots (no slots exist)
-	mockSlotRepo.EXPECT().
-		FetchSlotsByGameIDAndDate(gomock.Any(), gameID, today).
-		Return([]entities.Slot{}, nil).
-		Times(1)
+	// Mock FetchSlotsByGameIDAndDate to return empty slots (no slots exist) for every day of the horizon
+	for day := 0; day < config.BookingHorizonDays; day++ {
+		mockSlotRepo.EXPECT().
+			FetchSlotsByGameIDAndDate(gomock.Any(), gameID, today.AddDate(0, 0, day)).
+			Return([]entities.Slot{}, nil).
+			Times(1)
+	}
 
 	// Calculate the number of expected slot creations
 	location, err := time.LoadLocation("Asia/Kolkata")
@@ -130,9 +140,523 @@ func TestInsertAllSlots(t *testing.T) {
 	mockSlotRepo.EXPECT().
 		CreateSlot(gomock.Any(), gomock.Any()).
 		Return(uuid.New(), nil).
-		Times(expectedSlotCount) // Expect the number of slots created
+		Times(expectedSlotCount * config.BookingHorizonDays) // Expect the number of slots created
 
 	// Call the function to test
-	err = utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo)
+	slots, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+	assert.Len(t, slots, expectedSlotCount*config.BookingHorizonDays)
+}
+
+func TestInsertAllSlots_FillsInMissingSlots(t *testing.T) {
+	openEveryDay(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+	mockHolidayRepo.EXPECT().FetchHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	location, err := time.LoadLocation(config.TimeZone)
+	require.NoError(t, err)
+
+	// Chess now runs 09:00-12:00 with one-hour slots. Every day still has the 09:00 slot and a booked
+	// 20 minute slot at 11:00 from the old schedule, while the 10:00 slot was removed by a lifted blackout.
+	game := entities.Game{GameID: uuid.New(), GameName: "Chess", OpenTime: "09:00", CloseTime: "12:00", SlotDuration: 60}
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockSlotRepo.EXPECT().
+		FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, date time.Time) ([]entities.Slot, error) {
+			at := func(hour int) time.Time {
+				return time.Date(date.Year(), date.Month(), date.Day(), hour, 0, 0, 0, location)
+			}
+			return []entities.Slot{
+				{SlotID: uuid.New(), Instance: 1, StartTime: at(9), EndTime: at(10)},
+				{SlotID: uuid.New(), Instance: 1, StartTime: at(11), EndTime: at(11).Add(20 * time.Minute), IsBooked: true},
+			}, nil
+		}).
+		Times(config.BookingHorizonDays)
+
+	var created []*entities.Slot
+	mockSlotRepo.EXPECT().
+		CreateSlot(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, slot *entities.Slot) (uuid.UUID, error) {
+			created = append(created, slot)
+			return uuid.New(), nil
+		}).
+		Times(config.BookingHorizonDays)
+
+	slots, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+	assert.Len(t, slots, config.BookingHorizonDays)
+	for _, slot := range created {
+		assert.Equal(t, "10:00", slot.StartTime.In(location).Format("15:04"))
+	}
+}
+
+func TestGetGameSchedule(t *testing.T) {
+	game := entities.Game{
+		OpenTime:     "09:00",
+		CloseTime:    "20:00",
+		SlotDuration: 45,
+		WeekdaySchedules: map[time.Weekday]entities.GameSchedule{
+			time.Saturday: {OpenTime: "10:00", CloseTime: "14:00", SlotDuration: 30},
+		},
+	}
+
+	assert.Equal(t, entities.GameSchedule{OpenTime: "09:00", CloseTime: "20:00", SlotDuration: 45}, utils.GetGameSchedule(game, time.Monday))
+	assert.Equal(t, entities.GameSchedule{OpenTime: "10:00", CloseTime: "14:00", SlotDuration: 30}, utils.GetGameSchedule(game, time.Saturday))
+
+	// Games without a schedule fall back to the defaults
+	assert.Equal(t, entities.GameSchedule{OpenTime: "09:00", CloseTime: "18:00", SlotDuration: 20}, utils.GetGameSchedule(entities.Game{}, time.Monday))
+}
+
+func TestInsertAllSlots_UsesGameSchedule(t *testing.T) {
+	openEveryDay(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+	mockHolidayRepo.EXPECT().FetchHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	// Chess runs 09:00-18:00 with 45 minute slots, which gives 12 slots a day
+	game := entities.Game{GameID: uuid.New(), GameName: "Chess", OpenTime: "09:00", CloseTime: "18:00", SlotDuration: 45}
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockSlotRepo.EXPECT().
+		FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, gomock.Any()).
+		Return([]entities.Slot{}, nil).
+		Times(config.BookingHorizonDays)
+
+	var created []*entities.Slot
+	mockSlotRepo.EXPECT().
+		CreateSlot(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, slot *entities.Slot) (uuid.UUID, error) {
+			created = append(created, slot)
+			return uuid.New(), nil
+		}).
+		Times(12 * config.BookingHorizonDays)
+
+	_, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+
+	for _, slot := range created {
+		assert.Equal(t, 45*time.Minute, slot.EndTime.Sub(slot.StartTime))
+	}
+	assert.Equal(t, "09:00", created[0].StartTime.Format("15:04"))
+	assert.Equal(t, "18:00", created[11].EndTime.Format("15:04"))
+}
+
+func TestInsertAllSlots_CreatesSlotPerInstance(t *testing.T) {
+	openEveryDay(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+	mockHolidayRepo.EXPECT().FetchHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	// Two foosball tables with one-hour slots between 09:00 and 11:00
+	game := entities.Game{GameID: uuid.New(), GameName: "Foosball", Instances: 2, OpenTime: "09:00", CloseTime: "11:00", SlotDuration: 60}
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockSlotRepo.EXPECT().
+		FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, gomock.Any()).
+		Return([]entities.Slot{}, nil).
+		Times(config.BookingHorizonDays)
+
+	instancesByStart := map[time.Time][]int{}
+	mockSlotRepo.EXPECT().
+		CreateSlot(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, slot *entities.Slot) (uuid.UUID, error) {
+			instancesByStart[slot.StartTime] = append(instancesByStart[slot.StartTime], slot.Instance)
+			return uuid.New(), nil
+		}).
+		Times(2 * 2 * config.BookingHorizonDays)
+
+	_, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
 	require.NoError(t, err)
+
+	for _, instances := range instancesByStart {
+		assert.Equal(t, []int{1, 2}, instances)
+	}
+}
+
+func TestInsertAllSlots_SkipsBlackouts(t *testing.T) {
+	openEveryDay(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+	mockHolidayRepo.EXPECT().FetchHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	location, err := time.LoadLocation(config.TimeZone)
+	require.NoError(t, err)
+
+	// Carrom runs 09:00-12:00 with one-hour slots, and the room is closed from 10:00 to 11:00 today
+	game := entities.Game{GameID: uuid.New(), GameName: "Carrom", OpenTime: "09:00", CloseTime: "12:00", SlotDuration: 60}
+	today := utils.StartOfDay(utils.Now())
+	blackoutStart := time.Date(today.Year(), today.Month(), today.Day(), 10, 0, 0, 0, location)
+	otherGameID := uuid.New()
+
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockBlackoutRepo.EXPECT().
+		FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]entities.Blackout{
+			{StartTime: blackoutStart, EndTime: blackoutStart.Add(time.Hour)},
+			{GameID: &otherGameID, StartTime: blackoutStart.Add(time.Hour), EndTime: blackoutStart.Add(2 * time.Hour)},
+		}, nil)
+	mockSlotRepo.EXPECT().
+		FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, gomock.Any()).
+		Return([]entities.Slot{}, nil).
+		Times(config.BookingHorizonDays)
+
+	var created []*entities.Slot
+	mockSlotRepo.EXPECT().
+		CreateSlot(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, slot *entities.Slot) (uuid.UUID, error) {
+			created = append(created, slot)
+			return uuid.New(), nil
+		}).
+		Times(3*config.BookingHorizonDays - 1)
+
+	_, err = utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+
+	// The blackout of another game does not close the 11:00 slot
+	assert.Equal(t, "09:00", created[0].StartTime.Format("15:04"))
+	assert.Equal(t, "11:00", created[1].StartTime.Format("15:04"))
+}
+
+func TestInsertAllSlots_SkipsNonWorkingDaysAndHolidays(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+
+	// The room is open every day except today's weekday, and tomorrow is a holiday
+	today := utils.StartOfDay(utils.Now())
+	tomorrow := today.AddDate(0, 0, 1)
+	original := config.WorkingDays
+	defer func() { config.WorkingDays = original }()
+	config.WorkingDays = nil
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if weekday != today.Weekday() {
+			config.WorkingDays = append(config.WorkingDays, weekday)
+		}
+	}
+
+	game := entities.Game{GameID: uuid.New(), GameName: "Chess", OpenTime: "09:00", CloseTime: "10:00", SlotDuration: 60}
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockHolidayRepo.EXPECT().
+		FetchHolidaysBetween(gomock.Any(), today, today.AddDate(0, 0, config.BookingHorizonDays-1)).
+		Return([]entities.Holiday{{Date: tomorrow, Name: "Founders Day"}}, nil)
+
+	var openDays int
+	for day := 0; day < config.BookingHorizonDays; day++ {
+		date := today.AddDate(0, 0, day)
+		if date.Weekday() == today.Weekday() || date.Equal(tomorrow) {
+			mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, date).Times(0)
+			continue
+		}
+		openDays++
+		mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, date).Return([]entities.Slot{}, nil)
+	}
+	mockSlotRepo.EXPECT().CreateSlot(gomock.Any(), gomock.Any()).Return(uuid.New(), nil).Times(openDays)
+
+	slots, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+	assert.Len(t, slots, openDays)
+}
+
+func TestParseHolidayCalendar(t *testing.T) {
+	christmas := time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)
+	newYear := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := []entities.Holiday{{Date: christmas, Name: "Christmas"}, {Date: newYear, Name: "New Year"}}
+
+	calendars := map[string]string{
+		"csv":  "date,name\n2030-12-25,Christmas\n2031-01-01, New Year\n",
+		"yaml": "holidays:\n  - date: 2030-12-25\n    name: Christmas\n  - date: \"2031-01-01\"\n    name: New Year\n",
+		"ics": "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20301225\r\nSUMMARY:Christmas\r\nEND:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\nDTSTART:20310101T000000Z\r\nSUMMARY:New Year\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+	}
+	for format, calendar := range calendars {
+		holidays, err := utils.ParseHolidayCalendar(strings.NewReader(calendar), "."+format)
+		require.NoError(t, err, format)
+		assert.Equal(t, expected, holidays, format)
+	}
+
+	_, err := utils.ParseHolidayCalendar(strings.NewReader(""), ".txt")
+	assert.Error(t, err)
+	_, err = utils.ParseHolidayCalendar(strings.NewReader("2030-12-25,Christmas\n2030-13-01,Bad\n"), "csv")
+	assert.Error(t, err)
+}
+
+func TestParseHolidayCalendar_ICSMultiDayEvent(t *testing.T) {
+	calendar := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20301224\r\nDTEND;VALUE=DATE:20301227\r\nSUMMARY:Christmas break\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20310101\r\nDTEND;VALUE=DATE:20310102\r\nSUMMARY:New Year\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+	holidays, err := utils.ParseHolidayCalendar(strings.NewReader(calendar), "ics")
+	require.NoError(t, err)
+	assert.Equal(t, []entities.Holiday{
+		{Date: time.Date(2030, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Christmas break"},
+		{Date: time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Christmas break"},
+		{Date: time.Date(2030, 12, 26, 0, 0, 0, 0, time.UTC), Name: "Christmas break"},
+		{Date: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year"},
+	}, holidays)
+
+	_, err = utils.ParseHolidayCalendar(strings.NewReader("BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20301224\r\nDTEND:2030\r\nEND:VEVENT\r\n"), "ics")
+	assert.EqualError(t, err, `invalid event date "2030"`)
+}
+
+func TestParseHolidayCalendar_YAML(t *testing.T) {
+	christmas := time.Date(2030, 12, 25, 0, 0, 0, 0, time.UTC)
+	newYear := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := []entities.Holiday{{Date: christmas, Name: "Christmas"}, {Date: newYear, Name: "New Year"}}
+
+	calendars := []string{
+		"- date: 2030-12-25\n  name: Christmas\n- date: 2031-01-01\n  name: New Year\n",
+		"- {date: 2030-12-25, name: Christmas}\n- {date: 2031-01-01, name: New Year}\n",
+		"holidays: [{\"date\": \"2030-12-25\", \"name\": Christmas}, {'date': 2031-01-01, 'name': New Year}]\n",
+	}
+	for _, calendar := range calendars {
+		holidays, err := utils.ParseHolidayCalendar(strings.NewReader(calendar), "yml")
+		require.NoError(t, err, calendar)
+		assert.Equal(t, expected, holidays, calendar)
+	}
+
+	invalid := map[string]string{
+		"- name: Christmas\n":                 "holiday 1 of the yaml calendar needs a date",
+		"- date: 2030-12-25\n- date: 25/12\n": `invalid date "25/12" for holiday 2`,
+		"date: 2030-12-25\n":                  "the yaml calendar needs a list of holidays",
+	}
+	for calendar, message := range invalid {
+		_, err := utils.ParseHolidayCalendar(strings.NewReader(calendar), "yaml")
+		assert.EqualError(t, err, message, calendar)
+	}
+
+	_, err := utils.ParseHolidayCalendar(strings.NewReader("- date: [2030-12-25\n"), "yaml")
+	assert.ErrorContains(t, err, "invalid yaml calendar")
+}
+
+// openEveryDay makes every weekday a working day for the duration of the test
+func openEveryDay(t *testing.T) {
+	original := config.WorkingDays
+	t.Cleanup(func() { config.WorkingDays = original })
+	config.WorkingDays = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	weekdays, err := utils.ParseWeekdays("Tue, thursday,tue")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Weekday{time.Tuesday, time.Thursday}, weekdays)
+
+	_, err = utils.ParseWeekdays("Tue, Th")
+	assert.Error(t, err)
+}
+
+func TestStartOfDay_AroundMidnight(t *testing.T) {
+	useTimeZone(t, "Asia/Kolkata")
+	location := config.Location()
+
+	// 18:15 UTC is 23:45 in India and 18:45 UTC is already 00:15 the next day
+	beforeMidnight := time.Date(2026, 10, 16, 18, 15, 0, 0, time.UTC)
+	afterMidnight := time.Date(2026, 10, 16, 18, 45, 0, 0, time.UTC)
+
+	assert.True(t, utils.StartOfDay(beforeMidnight).Equal(time.Date(2026, 10, 16, 0, 0, 0, 0, location)))
+	assert.True(t, utils.StartOfDay(afterMidnight).Equal(time.Date(2026, 10, 17, 0, 0, 0, 0, location)))
+	assert.False(t, utils.IsSameDay(beforeMidnight, afterMidnight))
+	assert.Equal(t, "Sat, 17 Oct", utils.FormatDay(afterMidnight))
+	assert.Equal(t, "12:15 AM", utils.FormatClock(afterMidnight))
+	assert.Equal(t, "IST", utils.ZoneName(afterMidnight))
+}
+
+func TestStartOfDay_AcrossDST(t *testing.T) {
+	useTimeZone(t, "America/New_York")
+
+	// Clocks go forward on 8 March 2026 and back on 1 November 2026
+	springForward := utils.StartOfDay(time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2026-03-08 00:00 EST", springForward.Format("2006-01-02 15:04 MST"))
+	assert.Equal(t, 23*time.Hour, springForward.AddDate(0, 0, 1).Sub(springForward))
+
+	fallBack := utils.StartOfDay(time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2026-11-01 00:00 EDT", fallBack.Format("2006-01-02 15:04 MST"))
+	assert.Equal(t, 25*time.Hour, fallBack.AddDate(0, 0, 1).Sub(fallBack))
+}
+
+func TestInsertAllSlots_JustAfterMidnight(t *testing.T) {
+	openEveryDay(t)
+	useTimeZone(t, "Asia/Kolkata")
+	location := config.Location()
+
+	// It is 00:10 in India but still the previous day in UTC
+	freezeClock(t, time.Date(2026, 10, 16, 18, 40, 0, 0, time.UTC))
+	today := time.Date(2026, 10, 17, 0, 0, 0, 0, location)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+
+	game := entities.Game{GameID: uuid.New(), GameName: "Chess", OpenTime: "09:00", CloseTime: "10:00", SlotDuration: 60}
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockBlackoutRepo.EXPECT().
+		FetchBlackoutsBetween(gomock.Any(), today, today.AddDate(0, 0, config.BookingHorizonDays)).
+		Return(nil, nil)
+	mockHolidayRepo.EXPECT().
+		FetchHolidaysBetween(gomock.Any(), today, today.AddDate(0, 0, config.BookingHorizonDays-1)).
+		Return(nil, nil)
+	for day := 0; day < config.BookingHorizonDays; day++ {
+		mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, today.AddDate(0, 0, day)).Return([]entities.Slot{}, nil)
+	}
+	mockSlotRepo.EXPECT().CreateSlot(gomock.Any(), gomock.Any()).Return(uuid.New(), nil).Times(config.BookingHorizonDays)
+
+	slots, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+	require.Len(t, slots, config.BookingHorizonDays)
+	assert.True(t, slots[0].StartTime.Equal(time.Date(2026, 10, 17, 9, 0, 0, 0, location)))
+}
+
+func TestInsertAllSlots_AcrossDST(t *testing.T) {
+	openEveryDay(t)
+	useTimeZone(t, "America/New_York")
+	freezeClock(t, time.Date(2026, 3, 7, 17, 0, 0, 0, time.UTC))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSlotRepo := mocks.NewMockSlotRepository(ctrl)
+	mockGameRepo := mocks.NewMockGameRepository(ctrl)
+	mockBlackoutRepo := mocks.NewMockBlackoutRepository(ctrl)
+	mockHolidayRepo := mocks.NewMockHolidayRepository(ctrl)
+
+	game := entities.Game{GameID: uuid.New(), GameName: "Chess", OpenTime: "09:00", CloseTime: "10:00", SlotDuration: 60}
+	mockGameRepo.EXPECT().FetchAllGames(gomock.Any()).Return([]entities.Game{game}, nil)
+	mockBlackoutRepo.EXPECT().FetchBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockHolidayRepo.EXPECT().FetchHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockSlotRepo.EXPECT().FetchSlotsByGameIDAndDate(gomock.Any(), game.GameID, gomock.Any()).Return([]entities.Slot{}, nil).Times(config.BookingHorizonDays)
+	mockSlotRepo.EXPECT().CreateSlot(gomock.Any(), gomock.Any()).Return(uuid.New(), nil).Times(config.BookingHorizonDays)
+
+	slots, err := utils.InsertAllSlots(context.Background(), mockSlotRepo, mockGameRepo, mockBlackoutRepo, mockHolidayRepo)
+	require.NoError(t, err)
+	require.Len(t, slots, config.BookingHorizonDays)
+
+	// The game opens at 09:00 local time on both sides of the change, which is an hour earlier in UTC
+	for _, slot := range slots {
+		assert.Equal(t, "09:00", slot.StartTime.Format("15:04"))
+	}
+	assert.Equal(t, "14:00", slots[0].StartTime.UTC().Format("15:04"))
+	assert.Equal(t, "13:00", slots[1].StartTime.UTC().Format("15:04"))
+}
+
+// useTimeZone switches the configured time zone for the duration of the test
+func useTimeZone(t *testing.T, zone string) {
+	original := config.TimeZone
+	t.Cleanup(func() { config.TimeZone = original })
+	config.TimeZone = zone
+}
+
+// freezeClock makes utils.Now return the given time for the duration of the test
+func freezeClock(t *testing.T, now time.Time) {
+	original := utils.Now
+	t.Cleanup(func() { utils.Now = original })
+	utils.Now = func() time.Time { return now.In(config.Location()) }
+}
+
+func TestBuildAvailabilityGrid(t *testing.T) {
+	now := time.Date(2030, 1, 7, 10, 30, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return time.Date(2030, 1, 7, hour, 0, 0, 0, time.UTC) }
+
+	chess := entities.Game{GameID: uuid.New(), GameName: "Chess", MaxPlayers: 2}
+	carrom := entities.Game{GameID: uuid.New(), GameName: "Carrom", MaxPlayers: 4}
+	pool := entities.Game{GameID: uuid.New(), GameName: "Pool", MaxPlayers: 2}
+
+	occupancy := func(game entities.Game, instance, hour, booked int, isBooked bool) models.SlotOccupancy {
+		slot := entities.Slot{SlotID: uuid.New(), GameID: game.GameID, Instance: instance, StartTime: at(hour), IsBooked: isBooked}
+		return models.SlotOccupancy{Slot: slot, BookedCount: booked, Capacity: game.MaxPlayers}
+	}
+
+	// Carrom has two tables, Pool has no slot that day
+	chessPast := occupancy(chess, 1, 10, 0, false)
+	chessFull := occupancy(chess, 1, 11, 2, true)
+	carromFirst := occupancy(carrom, 1, 11, 3, false)
+	carromSecond := occupancy(carrom, 2, 11, 1, false)
+	chessFree := occupancy(chess, 1, 12, 1, false)
+	slots := []models.SlotOccupancy{chessFree, carromSecond, chessFull, carromFirst, chessPast}
+
+	grid := utils.BuildAvailabilityGrid([]entities.Game{chess, carrom, pool}, slots, now)
+
+	assert.Equal(t, []string{"Chess", "Carrom"}, grid.Games)
+	require.Len(t, grid.Rows, 3)
+	assert.True(t, grid.Rows[0].StartTime.Equal(at(10)))
+	assert.Equal(t, []string{"PAST", "-"}, grid.Rows[0].Cells)
+	assert.Equal(t, []string{"FULL", "4/8"}, grid.Rows[1].Cells)
+	assert.Equal(t, []string{"1/2", "-"}, grid.Rows[2].Cells)
+}
+
+func TestGetTotalScoreWithDraws(t *testing.T) {
+	// Two draws count as one win and one loss
+	assert.Equal(t, utils.GetTotalScoreWithDraws(3, 0, 3), utils.GetTotalScoreWithDraws(2, 2, 2))
+	assert.Equal(t, utils.GetTotalScore(3, 1), utils.GetTotalScoreWithDraws(3, 0, 1))
+}
+
+func TestFormatMatchResult(t *testing.T) {
+	score := func(value int) *int { return &value }
+
+	tests := []struct {
+		name     string
+		players  []models.MatchPlayerResult
+		expected string
+	}{
+		{
+			name: "winner first with the score",
+			players: []models.MatchPlayerResult{
+				{Username: "bob", Side: 1, Outcome: "loss", Score: score(18)},
+				{Username: "alice", Side: 2, Outcome: "win", Score: score(21)},
+			},
+			expected: "alice beat bob 21–18",
+		},
+		{
+			name: "draw without a score",
+			players: []models.MatchPlayerResult{
+				{Username: "alice", Side: 1, Outcome: "draw"},
+				{Username: "bob", Side: 2, Outcome: "draw"},
+			},
+			expected: "alice drew with bob",
+		},
+		{
+			name: "sides with several players",
+			players: []models.MatchPlayerResult{
+				{Username: "alice", Side: 1, Outcome: "win"},
+				{Username: "carol", Side: 1, Outcome: "win"},
+				{Username: "bob", Side: 2, Outcome: "loss"},
+				{Username: "dave", Side: 2, Outcome: "loss"},
+			},
+			expected: "alice & carol beat bob & dave",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, utils.FormatMatchResult(test.players))
+		})
+	}
 }
@@ -62,3 +62,23 @@ func TestIsValidGender(t *testing.T) {
 		}
 	}
 }
+
+func TestIsValidTimeOfDay(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"09:00", true},
+		{"23:59", true},
+		{"9:00", false},
+		{"24:00", false},
+		{"09:60", false},
+		{"9am", false},
+	}
+
+	for _, test := range tests {
+		if result := validation.IsValidTimeOfDay(test.value); result != test.expected {
+			t.Errorf("IsValidTimeOfDay(%s) = %v; want %v", test.value, result, test.expected)
+		}
+	}
+}